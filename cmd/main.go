@@ -1,29 +1,66 @@
 package main
 
 import (
-	"go.uber.org/zap"
+	"context"
+	"database/sql"
+	"log"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/logging"
 	"github.com/alexzh7/sample-service/internal/server"
 	"github.com/alexzh7/sample-service/pkg/postgres"
+	"github.com/alexzh7/sample-service/pkg/tracing"
 	_ "github.com/lib/pq"
 )
 
 func main() {
-	// Create logger
-	l := zap.NewExample().Sugar()
-	defer l.Sync()
-
 	// Load config
 	config, err := config.NewConfig()
 	if err != nil {
-		l.Fatalf("Config init: %v", err)
+		log.Fatalf("Config init: %v", err)
+	}
+
+	// Create logger
+	l, err := logging.NewLogger(config.Log)
+	if err != nil {
+		log.Fatalf("Logger init: %v", err)
 	}
+	defer l.Sync()
 
-	// Create db connection
-	dbConn, err := postgres.NewPostgresConn(config)
+	// Create tracer provider, spans are flushed on exit
+	tp, err := tracing.NewTracerProvider(config, "dvdstore")
 	if err != nil {
-		l.Fatalf("Postgresql init: %v", err)
+		l.Fatalf("Tracing init: %v", err)
+	}
+	defer func() {
+		if err := tp.Shutdown(context.Background()); err != nil {
+			l.Errorf("Tracing shutdown: %v", err)
+		}
+	}()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	// Run migrate command instead of the server if requested
+	migrateCmd := len(os.Args) > 1 && os.Args[1] == "migrate"
+
+	// Create db connection, memory repository doesn't need it
+	var dbConn *sql.DB
+	if !config.Repository.InMemory() || migrateCmd {
+		if dbConn, err = postgres.NewPostgresConn(config); err != nil {
+			l.Fatalf("Postgresql init: %v", err)
+		}
+	}
+
+	if migrateCmd {
+		if err := runMigrate(context.Background(), dbConn, os.Args[2:]); err != nil {
+			l.Fatalf("Migrate: %v", err)
+		}
+		return
 	}
 
 	// Run server
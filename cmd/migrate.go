@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/alexzh7/sample-service/pkg/migrate"
+	"github.com/alexzh7/sample-service/schema"
+)
+
+// migrateUsage describes migrate command
+const migrateUsage = `usage: migrate up | down [steps] | status | baseline <version>
+  up                  apply all pending migrations
+  down [steps]        revert steps latest migrations, 1 by default
+  status              list migrations and times they were applied
+  baseline <version>  record migrations up to version as applied without running them`
+
+// runMigrate runs migrate command with passed arguments
+func runMigrate(ctx context.Context, db *sql.DB, args []string) error {
+	migrator, err := migrate.New(db, schema.Migrations)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	var done []*migrate.Migration
+	switch cmd := args[0]; {
+	case cmd == "up" && len(args) == 1:
+		done, err = migrator.Up(ctx)
+	case cmd == "down" && len(args) <= 2:
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return errors.New(migrateUsage)
+			}
+		}
+		done, err = migrator.Down(ctx, steps)
+	case cmd == "baseline" && len(args) == 2:
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil || version <= 0 {
+			return errors.New(migrateUsage)
+		}
+		done, err = migrator.Baseline(ctx, version)
+	case cmd == "status" && len(args) == 1:
+		return printStatus(ctx, migrator)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	for _, m := range done {
+		fmt.Printf("%v %v_%v\n", args[0], m.Version, m.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(done) == 0 {
+		fmt.Println("no migrations to " + args[0])
+	}
+	return nil
+}
+
+// printStatus prints migrations with times they were applied
+func printStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+	for _, s := range statuses {
+		applied := "pending"
+		if s.AppliedAt != nil {
+			applied = s.AppliedAt.Format("2006-01-02 15:04:05 MST")
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\n", s.Version, s.Name, applied)
+	}
+	return w.Flush()
+}
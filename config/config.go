@@ -2,14 +2,51 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Application configuration
 type Config struct {
-	Postgres PostgresConfig
-	GRPC     GRPCConfig
+	Log        LogConfig
+	Repository RepositoryConfig
+	Postgres   PostgresConfig
+	GRPC       GRPCConfig
+	HTTP       HTTPConfig
+	Tax        TaxConfig
+	Orders     OrdersConfig
+	Payments   PaymentsConfig
+	Health     HealthConfig
+	Tracing    TracingConfig
+	Auth       AuthConfig
+}
+
+// Logger config
+type LogConfig struct {
+	// Level is a minimal level of logged messages: debug, info, warn or error
+	Level string
+	// Encoding is "json" or "console"
+	Encoding string
+}
+
+// Repository drivers
+const (
+	RepositoryPostgres = "postgres"
+	RepositoryMemory   = "memory"
+)
+
+// Repository config
+type RepositoryConfig struct {
+	// Driver is where data is kept: "postgres" or "memory". Memory data is lost on exit
+	Driver string
+	// SampleData fills memory repository with sample categories and products on start
+	SampleData bool
+}
+
+// InMemory reports if memory repository is used
+func (r RepositoryConfig) InMemory() bool {
+	return r.Driver == RepositoryMemory
 }
 
 // Postgresql config
@@ -19,11 +56,114 @@ type PostgresConfig struct {
 	User     string
 	Password string
 	DBName   string
+	// SSLMode is a libpq sslmode: disable, require, verify-ca or verify-full
+	SSLMode string
+	// SSLRootCert is a PEM file of CAs verifying the server in verify-ca
+	// and verify-full modes, it is read on every new connection
+	SSLRootCert string
+	// AutoMigrate applies pending schema migrations on start
+	AutoMigrate bool
 }
 
 // GRPC config
 type GRPCConfig struct {
 	Port string
+	TLS  TLSConfig
+}
+
+// TLS config of a server
+type TLSConfig struct {
+	// CertFile and KeyFile are PEM server certificate and key, TLS is off if they are empty
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is a PEM file of CAs verifying client certificates. If it is set,
+	// clients must present a certificate (mutual TLS)
+	ClientCAFile string
+	// ReloadInterval is how often files are checked for changes
+	ReloadInterval time.Duration
+}
+
+// HTTP config of REST/JSON API
+type HTTPConfig struct {
+	Port string
+}
+
+// Tax config
+type TaxConfig struct {
+	// DefaultRate is applied if no rate matches customer location
+	DefaultRate float64
+	// Rates are tax rates for customer locations. The most specific
+	// matching rate is applied: state, then country, then region
+	Rates []TaxRateConfig
+	// ExemptCategories are ids of product categories that are not taxed
+	ExemptCategories []int
+}
+
+// Tax rate for customer location. Empty fields match any location
+type TaxRateConfig struct {
+	Region  int
+	Country string
+	State   string
+	Rate    float64
+}
+
+// Orders config
+type OrdersConfig struct {
+	// IdempotencyRetention is how long AddOrder idempotency keys are kept
+	IdempotencyRetention time.Duration
+	// CartExpiry is how long carts are kept without changes
+	CartExpiry time.Duration
+}
+
+// Payment providers
+const (
+	PaymentsFake = "fake"
+)
+
+// Payments config
+type PaymentsConfig struct {
+	// Provider tokenizes payment cards and authorizes payments: "fake" issues tokens
+	// without keeping cards, declines card 4000000000000002 and payments over $10,000
+	Provider string
+}
+
+// Health config
+type HealthConfig struct {
+	// CheckInterval is how often the database is pinged
+	CheckInterval time.Duration
+	// Timeout is a timeout of a single database ping
+	Timeout time.Duration
+}
+
+// Tracing config
+type TracingConfig struct {
+	// Exporter is where spans are sent: "none", "stdout" or "otlp"
+	Exporter string
+	// Endpoint is host:port of OTLP/HTTP collector
+	Endpoint string
+	// Insecure disables TLS of OTLP exporter
+	Insecure bool
+	// SampleRatio is a fraction of new traces that are sampled. Traces
+	// propagated from callers follow the caller's sampling decision
+	SampleRatio float64
+}
+
+// Auth config
+type AuthConfig struct {
+	// TokenSecret signs customer tokens, must be at least 32 bytes
+	TokenSecret string
+	// TokenTTL is how long issued tokens are valid
+	TokenTTL time.Duration
+	// APIKeys authenticate service callers
+	APIKeys []APIKeyConfig
+}
+
+// API key of a service caller
+type APIKeyConfig struct {
+	// Name identifies the service
+	Name string
+	// Key must be at least 32 bytes
+	Key string
 }
 
 // NewConfig parses config file and returns app config
@@ -31,6 +171,22 @@ func NewConfig() (*Config, error) {
 	v := viper.New()
 	v.SetConfigName("config")
 	v.AddConfigPath("./config")
+	v.SetDefault("log.Level", "info")
+	v.SetDefault("log.Encoding", "json")
+	v.SetDefault("repository.Driver", RepositoryPostgres)
+	v.SetDefault("postgres.SSLMode", "disable")
+	v.SetDefault("grpc.TLS.ReloadInterval", 30*time.Second)
+	v.SetDefault("orders.IdempotencyRetention", 24*time.Hour)
+	v.SetDefault("orders.CartExpiry", 7*24*time.Hour)
+	v.SetDefault("payments.Provider", PaymentsFake)
+	v.SetDefault("health.CheckInterval", 5*time.Second)
+	v.SetDefault("health.Timeout", 2*time.Second)
+	v.SetDefault("tracing.Exporter", "none")
+	v.SetDefault("tracing.SampleRatio", 1)
+	v.SetDefault("auth.TokenTTL", time.Hour)
+	v.BindEnv("auth.TokenSecret", "DVDSTORE_TOKEN_SECRET")
+	v.BindEnv("postgres.AutoMigrate", "DVDSTORE_AUTO_MIGRATE")
+	v.BindEnv("repository.Driver", "DVDSTORE_REPOSITORY")
 
 	if err := v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("config.ReadInConfig: %v", err)
@@ -40,6 +196,15 @@ func NewConfig() (*Config, error) {
 	if err := v.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("config.Unmarshal: %v", err)
 	}
+	if d := config.Repository.Driver; d != RepositoryPostgres && d != RepositoryMemory {
+		return nil, fmt.Errorf("config: unknown repository driver %q", d)
+	}
+	if p := config.Payments.Provider; p != PaymentsFake {
+		return nil, fmt.Errorf("config: unknown payments provider %q", p)
+	}
+	if config.Auth.TokenSecret == "" {
+		return nil, fmt.Errorf("config: auth.TokenSecret is not set, pass it with DVDSTORE_TOKEN_SECRET")
+	}
 
 	return &config, nil
 }
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// minKeyLen is a minimal length of API key in bytes
+const minKeyLen = 32
+
+// apiKey is a named API key, only its digest is kept in memory
+type apiKey struct {
+	name   string
+	digest [sha256.Size]byte
+}
+
+// apiKeys authenticates service callers by configured API keys. It implements
+// APIKeys interface
+type apiKeys struct {
+	keys []apiKey
+}
+
+// NewAPIKeys returns API keys of service callers. Returns error if a key is shorter
+// than 32 bytes or names or keys are not unique
+func NewAPIKeys(keys []config.APIKeyConfig) (*apiKeys, error) {
+	a := &apiKeys{keys: make([]apiKey, 0, len(keys))}
+	names := make(map[string]bool, len(keys))
+	digests := make(map[[sha256.Size]byte]bool, len(keys))
+	for _, k := range keys {
+		if k.Name == "" {
+			return nil, fmt.Errorf("NewAPIKeys: key name must not be empty")
+		}
+		if len(k.Key) < minKeyLen {
+			return nil, fmt.Errorf("NewAPIKeys: key %q must be at least %v bytes", k.Name, minKeyLen)
+		}
+		digest := sha256.Sum256([]byte(k.Key))
+		if names[k.Name] || digests[digest] {
+			return nil, fmt.Errorf("NewAPIKeys: key %q is duplicated", k.Name)
+		}
+		names[k.Name], digests[digest] = true, true
+		a.keys = append(a.keys, apiKey{name: k.Name, digest: digest})
+	}
+	return a, nil
+}
+
+// Verify returns identity of the service owning the key. Returns AuthError if key is unknown
+func (a *apiKeys) Verify(key string) (*models.Identity, error) {
+	digest := sha256.Sum256([]byte(key))
+	// Compare with every key so time doesn't depend on the matched one
+	name := ""
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare(digest[:], k.digest[:]) == 1 {
+			name = k.name
+		}
+	}
+	if name == "" {
+		return nil, &models.AuthError{Message: "invalid api key"}
+	}
+	return &models.Identity{Name: name, Role: models.RoleService}, nil
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKeys(t *testing.T) {
+	key := strings.Repeat("k", 32)
+	tests := map[string][]config.APIKeyConfig{
+		"empty name":     {{Name: "", Key: key}},
+		"short key":      {{Name: "reporting", Key: "short"}},
+		"duplicate name": {{Name: "reporting", Key: key}, {Name: "reporting", Key: key + "x"}},
+		"duplicate key":  {{Name: "reporting", Key: key}, {Name: "billing", Key: key}},
+	}
+	for name, keys := range tests {
+		_, err := NewAPIKeys(keys)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestAPIKeysVerify(t *testing.T) {
+	keys, err := NewAPIKeys([]config.APIKeyConfig{
+		{Name: "reporting", Key: strings.Repeat("r", 32)},
+		{Name: "billing", Key: strings.Repeat("b", 32)},
+	})
+	assert.NoError(t, err)
+
+	identity, err := keys.Verify(strings.Repeat("b", 32))
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Identity{Name: "billing", Role: models.RoleService}, identity)
+
+	_, err = keys.Verify(strings.Repeat("x", 32))
+	var authErr *models.AuthError
+	assert.ErrorAs(t, err, &authErr)
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// issuer is set to issued tokens and required from verified ones
+const issuer = "dvdstore"
+
+// minSecretLen is a minimal length of token signing secret in bytes
+const minSecretLen = 32
+
+// claims of customer token. Role is empty in tokens issued before roles were added,
+// such tokens belong to customers
+type claims struct {
+	jwt.RegisteredClaims
+	Role models.Role `json:"role,omitempty"`
+}
+
+// tokenManager issues and verifies customer tokens signed with HMAC-SHA256.
+// It implements TokenManager interface
+type tokenManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokenManager returns manager of tokens signed with secret that expire after ttl.
+// Returns error if secret is shorter than 32 bytes or ttl is not positive
+func NewTokenManager(secret string, ttl time.Duration) (*tokenManager, error) {
+	if len(secret) < minSecretLen {
+		return nil, fmt.Errorf("NewTokenManager: secret must be at least %v bytes", minSecretLen)
+	}
+	if ttl <= 0 {
+		return nil, errors.New("NewTokenManager: ttl must be > 0")
+	}
+	return &tokenManager{secret: []byte(secret), ttl: ttl}, nil
+}
+
+// Issue returns signed token of the customer with his role and its expiration time
+func (t *tokenManager) Issue(customerId int, role models.Role) (token string, expiresAt time.Time, err error) {
+	if !tokenRole(role) {
+		return "", time.Time{}, fmt.Errorf("Issue: role %q can't be issued a token", role)
+	}
+	now := time.Now()
+	expiresAt = now.Add(t.ttl)
+	c := claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   strconv.Itoa(customerId),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Role: role,
+	}
+
+	token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(t.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("jwt.SignedString: %v", err)
+	}
+	return token, expiresAt, nil
+}
+
+// Verify returns identity of the token owner. Returns AuthError if token is malformed,
+// has wrong signature or expired
+func (t *tokenManager) Verify(token string) (*models.Identity, error) {
+	c := claims{}
+	_, err := jwt.ParseWithClaims(token, &c, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return t.secret, nil
+	})
+	if err != nil {
+		return nil, &models.AuthError{Message: fmt.Sprintf("invalid token: %v", err)}
+	}
+	if !c.VerifyIssuer(issuer, true) {
+		return nil, &models.AuthError{Message: "invalid token: unknown issuer"}
+	}
+	if c.ExpiresAt == nil {
+		return nil, &models.AuthError{Message: "invalid token: no expiration time"}
+	}
+
+	customerId, err := strconv.Atoi(c.Subject)
+	if err != nil || customerId <= 0 {
+		return nil, &models.AuthError{Message: "invalid token: unknown subject"}
+	}
+	if c.Role == "" {
+		c.Role = models.RoleCustomer
+	}
+	if !tokenRole(c.Role) {
+		return nil, &models.AuthError{Message: "invalid token: unknown role"}
+	}
+	return &models.Identity{CustomerId: customerId, Role: c.Role}, nil
+}
+
+// tokenRole reports if role belongs to customer accounts that log in with tokens.
+// Services authenticate with API keys
+func tokenRole(role models.Role) bool {
+	return role.Valid() && role != models.RoleService
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "test-secret-0123456789abcdef0123456789"
+
+func TestNewTokenManager(t *testing.T) {
+	_, err := NewTokenManager("short", time.Hour)
+	assert.Error(t, err)
+
+	_, err = NewTokenManager(testSecret, 0)
+	assert.Error(t, err)
+}
+
+func TestIssueVerify(t *testing.T) {
+	tm, err := NewTokenManager(testSecret, time.Hour)
+	assert.NoError(t, err)
+
+	token, expiresAt, err := tm.Issue(7, models.RoleStaff)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	identity, err := tm.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Identity{CustomerId: 7, Role: models.RoleStaff}, identity)
+
+	_, _, err = tm.Issue(7, models.RoleService)
+	assert.Error(t, err)
+}
+
+func TestVerifyWithoutRole(t *testing.T) {
+	tm, _ := NewTokenManager(testSecret, time.Hour)
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Issuer: issuer,
+		Subject: "7", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}).SignedString([]byte(testSecret))
+
+	identity, err := tm.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Identity{CustomerId: 7, Role: models.RoleCustomer}, identity)
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	tm, _ := NewTokenManager(testSecret, time.Hour)
+	other, _ := NewTokenManager(strings.Repeat("x", 32), time.Hour)
+	expired, _ := NewTokenManager(testSecret, time.Nanosecond)
+
+	otherToken, _, _ := other.Issue(7, models.RoleCustomer)
+	expiredToken, _, _ := expired.Issue(7, models.RoleCustomer)
+	time.Sleep(time.Second)
+	noneToken, _ := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.RegisteredClaims{Issuer: issuer,
+		Subject: "7"}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	noExpToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Issuer: issuer,
+		Subject: "7"}).SignedString([]byte(testSecret))
+	serviceToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{Issuer: issuer, Subject: "7",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Role: models.RoleService,
+	}).SignedString([]byte(testSecret))
+
+	tests := map[string]string{
+		"malformed":       "abc",
+		"wrong signature": otherToken,
+		"expired":         expiredToken,
+		"unsigned":        noneToken,
+		"no expiration":   noExpToken,
+		"service role":    serviceToken,
+	}
+	for name, token := range tests {
+		_, err := tm.Verify(token)
+		var authErr *models.AuthError
+		assert.ErrorAs(t, err, &authErr, name)
+	}
+}
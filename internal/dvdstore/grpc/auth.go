@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// authorizationKey is a metadata key of the bearer token
+const authorizationKey = "authorization"
+
+// APIKeyHeader is a metadata key and http header of service API key
+const APIKeyHeader = "x-api-key"
+
+// Groups of roles allowed to call methods
+var (
+	adminRoles    = []models.Role{models.RoleAdmin}
+	staffRoles    = []models.Role{models.RoleAdmin, models.RoleStaff}
+	backOffice    = []models.Role{models.RoleAdmin, models.RoleStaff, models.RoleService}
+	authenticated = []models.Role{models.RoleAdmin, models.RoleStaff, models.RoleService, models.RoleCustomer}
+)
+
+// permissions lists roles allowed to call dvd store methods. Methods with nil roles are
+// public, methods missing in the table are denied to everyone. Use case additionally
+// limits customers to their own data
+var permissions = map[string][]models.Role{
+	"GetCustomers":        backOffice,
+	"GetCustomer":         authenticated,
+	"AddCustomer":         nil,
+	"Login":               nil,
+	"UpdateCustomer":      {models.RoleAdmin, models.RoleStaff, models.RoleCustomer},
+	"DeleteCustomer":      adminRoles,
+	"GetProducts":         nil,
+	"SearchProducts":      nil,
+	"GetProduct":          nil,
+	"AddProduct":          staffRoles,
+	"UpdateProduct":       staffRoles,
+	"AdjustInventory":     backOffice,
+	"DeleteProduct":       adminRoles,
+	"ListCategories":      nil,
+	"AddCategory":         staffRoles,
+	"GetOrder":            backOffice,
+	"GetCustomerOrders":   authenticated,
+	"AddOrder":            authenticated,
+	"CancelOrder":         backOffice,
+	"TransitionOrder":     backOffice,
+	"ReturnOrderLines":    backOffice,
+	"DeleteOrder":         adminRoles,
+	"AddPaymentMethod":    {models.RoleAdmin, models.RoleStaff, models.RoleCustomer},
+	"GetPaymentMethods":   authenticated,
+	"DeletePaymentMethod": {models.RoleAdmin, models.RoleStaff, models.RoleCustomer},
+	"GetCart":             authenticated,
+	"AddToCart":           authenticated,
+	"RemoveFromCart":      authenticated,
+	"Checkout":            authenticated,
+}
+
+// AuthInterceptor authenticates caller with bearer token from "authorization" metadata, with
+// API key from "x-api-key" metadata or with verified client certificate of mutual TLS, puts
+// identity of the caller to the handler context and checks that the caller may call dvd store
+// method. Calls of other services are only authenticated. Calls with invalid credentials are
+// rejected
+func AuthInterceptor(tokens dvdstore.TokenManager, keys dvdstore.APIKeys) grpc.UnaryServerInterceptor {
+	prefix := "/" + proto.Dvdstore_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		identity, err := Authenticate(tokens, keys, first(md.Get(authorizationKey)), first(md.Get(APIKeyHeader)))
+		if err != nil {
+			return nil, grpcError(ctx, err)
+		}
+		if identity == nil {
+			identity = peerIdentity(ctx)
+		}
+		if identity != nil {
+			ctx = models.WithIdentity(ctx, identity)
+		}
+
+		if method := strings.TrimPrefix(info.FullMethod, prefix); method != info.FullMethod {
+			if err := Authorize(ctx, method); err != nil {
+				return nil, grpcError(ctx, err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Authenticate returns identity of the caller by authorization value or API key. Returns
+// nil identity if both are empty and AuthError if credentials are not valid
+func Authenticate(tokens dvdstore.TokenManager, keys dvdstore.APIKeys, authorization,
+	apiKey string) (*models.Identity, error) {
+	switch {
+	case authorization != "":
+		token, ok := bearerToken(authorization)
+		if !ok {
+			return nil, &models.AuthError{Message: "authorization must be a bearer token"}
+		}
+		return tokens.Verify(token)
+	case apiKey != "":
+		return keys.Verify(apiKey)
+	}
+	return nil, nil
+}
+
+// peerIdentity returns service identity of the client certificate verified by mutual TLS
+// or nil if the peer has no verified certificate
+func peerIdentity(ctx context.Context) *models.Identity {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return &models.Identity{Name: tlsInfo.State.VerifiedChains[0][0].Subject.CommonName,
+		Role: models.RoleService}
+}
+
+// Authorize checks that the caller may call dvd store method. Returns AuthError if
+// anonymous caller calls not public method and PermissionError if caller's role is not allowed
+func Authorize(ctx context.Context, method string) error {
+	roles, ok := permissions[method]
+	if ok && roles == nil {
+		return nil
+	}
+
+	identity, authenticated := models.IdentityFromContext(ctx)
+	if !authenticated {
+		return models.ErrUnauthenticated
+	}
+	for _, role := range roles {
+		if identity.Role == role {
+			return nil
+		}
+	}
+	return &models.PermissionError{
+		Entity:  "method",
+		Message: fmt.Sprintf("%v is not allowed for role %q", method, identity.Role),
+	}
+}
+
+// bearerToken returns token from "Bearer <token>" authorization value
+func bearerToken(authorization string) (string, bool) {
+	const prefix = "bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return "", false
+	}
+	return authorization[len(prefix):], true
+}
+
+// first returns the first of metadata values or empty string
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionsCoverService(t *testing.T) {
+	assert.Len(t, permissions, len(proto.Dvdstore_ServiceDesc.Methods))
+	for _, m := range proto.Dvdstore_ServiceDesc.Methods {
+		_, ok := permissions[m.MethodName]
+		assert.True(t, ok, m.MethodName)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	identity := func(role models.Role) context.Context {
+		return models.WithIdentity(context.Background(), &models.Identity{CustomerId: 5, Role: role})
+	}
+	var permErr *models.PermissionError
+
+	assert.NoError(t, Authorize(context.Background(), "GetProducts"))
+	assert.Equal(t, models.ErrUnauthenticated, Authorize(context.Background(), "GetCustomerOrders"))
+	assert.NoError(t, Authorize(identity(models.RoleCustomer), "GetCustomerOrders"))
+	assert.ErrorAs(t, Authorize(identity(models.RoleCustomer), "AddProduct"), &permErr)
+	assert.NoError(t, Authorize(identity(models.RoleStaff), "AddProduct"))
+	assert.ErrorAs(t, Authorize(identity(models.RoleStaff), "DeleteProduct"), &permErr)
+	assert.NoError(t, Authorize(identity(models.RoleAdmin), "DeleteProduct"))
+	assert.ErrorAs(t, Authorize(identity(models.RoleAdmin), "Unknown"), &permErr)
+}
+
+func TestBearerToken(t *testing.T) {
+	token, ok := bearerToken("bearer abc")
+	assert.True(t, ok)
+	assert.Equal(t, "abc", token)
+
+	_, ok = bearerToken("Basic abc")
+	assert.False(t, ok)
+}
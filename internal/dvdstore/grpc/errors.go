@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"context"
 	"errors"
 
 	"github.com/alexzh7/sample-service/internal/models"
@@ -9,20 +10,41 @@ import (
 )
 
 // grpcError returns valid errors for grpc
-func grpcError(err error) error {
-	return status.Errorf(getGrpcCode(err), err.Error())
+func grpcError(ctx context.Context, err error) error {
+	return ErrorStatus(ctx, err).Err()
+}
+
+// ErrorStatus returns grpc status of the error returned by use case. If request context
+// is done, returns Canceled or DeadlineExceeded status instead of the error it caused.
+// Other transports use it to report errors the same way as grpc does
+func ErrorStatus(ctx context.Context, err error) *status.Status {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr)
+	}
+	return status.New(getGrpcCode(err), err.Error())
 }
 
 // getGrpcCode assigns grpc error codes according to package errors
 func getGrpcCode(err error) codes.Code {
 	var validationErr *models.ValidationError
 	var entityErr *models.EntityError
+	var conflictErr *models.ConflictError
+	var authErr *models.AuthError
+	var permissionErr *models.PermissionError
 	switch {
 	case errors.As(err, &validationErr):
 		return codes.InvalidArgument
 	case errors.As(err, &entityErr):
 		return codes.NotFound
-	case errors.Is(err, models.ErrGeneralDBFail):
+	case errors.As(err, &conflictErr):
+		return codes.FailedPrecondition
+	case errors.As(err, &authErr):
+		return codes.Unauthenticated
+	case errors.As(err, &permissionErr):
+		return codes.PermissionDenied
+	case errors.Is(err, models.ErrPaymentFail):
+		return codes.Unavailable
+	case errors.Is(err, models.ErrGeneralDBFail), errors.Is(err, models.ErrInternal):
 		return codes.Internal
 	}
 	return codes.Internal
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/logging"
 	"github.com/alexzh7/sample-service/internal/models"
 	"github.com/alexzh7/sample-service/proto"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // dvdstoreService is a grpc service for dvd store. It implements grpc server interface
@@ -21,15 +23,24 @@ func NewDvdstoreService(uc dvdstore.Usecase, log *zap.SugaredLogger) *dvdstoreSe
 	return &dvdstoreService{uc: uc, log: log}
 }
 
-// GetCustomers returns list of all Customers limited by provided limit
+// logger returns request logger from context or service logger if there is none
+func (d *dvdstoreService) logger(ctx context.Context) *zap.SugaredLogger {
+	return logging.FromContext(ctx, d.log)
+}
+
+// GetCustomers returns page of Customers limited by provided limit and sorted by provided field
 func (d *dvdstoreService) GetCustomers(ctx context.Context, req *proto.GetCustomersReq) (*proto.GetCustomersRes, error) {
-	limit := int(req.GetLimit())
-	d.log.Infof("Received GetCustomers call with limit %v", limit)
+	page := models.PageRequest{
+		Limit:     int(req.GetLimit()),
+		SortBy:    req.GetSortBy(),
+		PageToken: req.GetPageToken(),
+	}
+	d.logger(ctx).Debugf("Received GetCustomers call with limit %v", page.Limit)
 
 	// Get customers
-	customers, err := d.uc.GetCustomers(limit)
+	customers, next, err := d.uc.GetCustomers(ctx, page)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	// Form response
@@ -38,17 +49,17 @@ func (d *dvdstoreService) GetCustomers(ctx context.Context, req *proto.GetCustom
 		customersProto = append(customersProto, c.ToProto())
 	}
 
-	return &proto.GetCustomersRes{CustomerList: customersProto}, nil
+	return &proto.GetCustomersRes{CustomerList: customersProto, NextPageToken: next}, nil
 }
 
 // GetCustomer returns Customer by provided id
 func (d *dvdstoreService) GetCustomer(ctx context.Context, req *proto.GetCustomerReq) (*proto.GetCustomerRes, error) {
 	customerId := int(req.GetCustomerID())
-	d.log.Infof("Received GetCustomer call with id %v", customerId)
+	d.logger(ctx).Debugf("Received GetCustomer call with id %v", customerId)
 
-	customer, err := d.uc.GetCustomer(customerId)
+	customer, err := d.uc.GetCustomer(ctx, customerId)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.GetCustomerRes{Customer: customer.ToProto()}, nil
@@ -56,46 +67,116 @@ func (d *dvdstoreService) GetCustomer(ctx context.Context, req *proto.GetCustome
 
 // AddCustomer adds passed Customer and returns his id
 func (d *dvdstoreService) AddCustomer(ctx context.Context, req *proto.AddCustomerReq) (*proto.AddCustomerRes, error) {
-	d.log.Info("Received AddCustomer call")
+	d.logger(ctx).Debug("Received AddCustomer call")
 
-	// Map proto.Customer to models.Customer
-	cReq := req.GetCustomer()
-	customer := &models.Customer{
-		Id:        int(cReq.GetId()),
-		FirstName: cReq.GetFirstName(),
-		LastName:  cReq.GetLastName(),
-		Age:       int(cReq.GetAge()),
+	customer := models.CustomerFromProto(req.GetCustomer())
+	id, err := d.uc.AddCustomer(ctx, customer, req.GetPassword())
+	if err != nil {
+		return nil, grpcError(ctx, err)
 	}
 
-	id, err := d.uc.AddCustomer(customer)
+	return &proto.AddCustomerRes{CustomerID: int64(id)}, nil
+}
+
+// Login returns signed token of the customer with passed credentials
+func (d *dvdstoreService) Login(ctx context.Context, req *proto.LoginReq) (*proto.LoginRes, error) {
+	d.logger(ctx).Debugf("Received Login call for username %q", req.GetUsername())
+
+	credentials := &models.Credentials{Username: req.GetUsername(), Password: req.GetPassword()}
+	token, expiresAt, err := d.uc.Login(ctx, credentials)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
-	return &proto.AddCustomerRes{CustomerID: int64(id)}, nil
+	return &proto.LoginRes{Token: token, ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
+// UpdateCustomer updates fields of Customer listed in update mask and returns updated Customer
+func (d *dvdstoreService) UpdateCustomer(ctx context.Context, req *proto.UpdateCustomerReq) (*proto.UpdateCustomerRes, error) {
+	customer := models.CustomerFromProto(req.GetCustomer())
+	d.logger(ctx).Debugf("Received UpdateCustomer call with id %v", customer.Id)
+
+	updated, err := d.uc.UpdateCustomer(ctx, customer, MaskFields(req.GetUpdateMask(), req.GetCustomer()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.UpdateCustomerRes{Customer: updated.ToProto()}, nil
 }
 
 // DeleteCustomer deletes Customer by provided id
 func (d *dvdstoreService) DeleteCustomer(ctx context.Context, req *proto.DeleteCustomerReq) (*proto.DeleteCustomerRes, error) {
 	customerId := int(req.GetCustomerID())
-	d.log.Infof("Received DeleteCustomer call with id %v", customerId)
+	d.logger(ctx).Debugf("Received DeleteCustomer call with id %v", customerId)
 
-	if err := d.uc.DeleteCustomer(customerId); err != nil {
-		return nil, grpcError(err)
+	if err := d.uc.DeleteCustomer(ctx, customerId); err != nil {
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.DeleteCustomerRes{}, nil
 }
 
-// GetProducts returns list of all Products limited by provided limit
+// GetProducts returns page of Products limited by provided limit and sorted by provided field
 func (d *dvdstoreService) GetProducts(ctx context.Context, req *proto.GetProductsReq) (*proto.GetProductsRes, error) {
-	limit := int(req.GetLimit())
-	d.log.Infof("Received GetProducts call with limit %v", limit)
+	page := models.PageRequest{
+		Limit:     int(req.GetLimit()),
+		SortBy:    req.GetSortBy(),
+		PageToken: req.GetPageToken(),
+	}
+	d.logger(ctx).Debugf("Received GetProducts call with limit %v", page.Limit)
 
 	// Get products
-	products, err := d.uc.GetProducts(limit)
+	products, next, err := d.uc.GetProducts(ctx, page)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	// Form response
+	productsRes := make([]*proto.Product, 0)
+	for _, p := range products {
+		productsRes = append(productsRes, p.ToProto())
+	}
+
+	return &proto.GetProductsRes{ProductList: productsRes, NextPageToken: next}, nil
+}
+
+// SearchProducts returns page of Products matching full-text query on
+// title and actor, categories, price range and stock availability
+func (d *dvdstoreService) SearchProducts(ctx context.Context, req *proto.SearchProductsReq) (*proto.SearchProductsRes, error) {
+	d.logger(ctx).Debugf("Received SearchProducts call with query %q", req.GetQuery())
+
+	if !models.ValidCurrency(req.GetMinPrice()) {
+		return nil, grpcError(ctx, models.ErrCurrencyNotValid("minPrice"))
+	}
+	if !models.ValidCurrency(req.GetMaxPrice()) {
+		return nil, grpcError(ctx, models.ErrCurrencyNotValid("maxPrice"))
+	}
+
+	filter := &models.ProductFilter{
+		Query:       req.GetQuery(),
+		InStockOnly: req.GetInStockOnly(),
+	}
+	for _, c := range req.GetCategories() {
+		filter.Categories = append(filter.Categories, int(c))
+	}
+	if req.MinPrice != nil {
+		minPrice := models.MoneyFromProto(req.GetMinPrice())
+		filter.MinPrice = &minPrice
+	}
+	if req.MaxPrice != nil {
+		maxPrice := models.MoneyFromProto(req.GetMaxPrice())
+		filter.MaxPrice = &maxPrice
+	}
+	page := models.PageRequest{
+		Limit:     int(req.GetLimit()),
+		SortBy:    req.GetSortBy(),
+		PageToken: req.GetPageToken(),
+	}
+
+	// Search products
+	products, next, err := d.uc.SearchProducts(ctx, filter, page)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	// Form response
@@ -104,17 +185,17 @@ func (d *dvdstoreService) GetProducts(ctx context.Context, req *proto.GetProduct
 		productsRes = append(productsRes, p.ToProto())
 	}
 
-	return &proto.GetProductsRes{ProductList: productsRes}, nil
+	return &proto.SearchProductsRes{ProductList: productsRes, NextPageToken: next}, nil
 }
 
 // GetProduct returns Product by provided id
 func (d *dvdstoreService) GetProduct(ctx context.Context, req *proto.GetProductReq) (*proto.GetProductRes, error) {
 	productId := int(req.GetProductID())
-	d.log.Infof("Received GetProduct call with id %v", productId)
+	d.logger(ctx).Debugf("Received GetProduct call with id %v", productId)
 
-	product, err := d.uc.GetProduct(productId)
+	product, err := d.uc.GetProduct(ctx, productId)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.GetProductRes{Product: product.ToProto()}, nil
@@ -122,51 +203,124 @@ func (d *dvdstoreService) GetProduct(ctx context.Context, req *proto.GetProductR
 
 // AddProduct adds passed Product and returns his id
 func (d *dvdstoreService) AddProduct(ctx context.Context, req *proto.AddProductReq) (*proto.AddProductRes, error) {
-	d.log.Info("Received AddProduct call")
+	d.logger(ctx).Debug("Received AddProduct call")
 
+	if !models.ValidCurrency(req.GetProduct().GetPrice()) {
+		return nil, grpcError(ctx, models.ErrCurrencyNotValid("price"))
+	}
 	product := models.ProductFromProto(req.GetProduct())
-	id, err := d.uc.AddProduct(product)
+	id, err := d.uc.AddProduct(ctx, product)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.AddProductRes{ProductID: int64(id)}, nil
 }
 
+// UpdateProduct updates fields of Product listed in update mask and returns updated Product
+func (d *dvdstoreService) UpdateProduct(ctx context.Context, req *proto.UpdateProductReq) (*proto.UpdateProductRes, error) {
+	product := models.ProductFromProto(req.GetProduct())
+	d.logger(ctx).Debugf("Received UpdateProduct call with id %v", product.Id)
+
+	if !models.ValidCurrency(req.GetProduct().GetPrice()) {
+		return nil, grpcError(ctx, models.ErrCurrencyNotValid("price"))
+	}
+
+	updated, err := d.uc.UpdateProduct(ctx, product, MaskFields(req.GetUpdateMask(), req.GetProduct()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.UpdateProductRes{Product: updated.ToProto()}, nil
+}
+
+// AdjustInventory changes Product quantity in stock by provided delta
+func (d *dvdstoreService) AdjustInventory(ctx context.Context, req *proto.AdjustInventoryReq) (*proto.AdjustInventoryRes, error) {
+	productId, delta := int(req.GetProductID()), int(req.GetDelta())
+	d.logger(ctx).Debugf("Received AdjustInventory call with id %v and delta %v", productId, delta)
+
+	product, err := d.uc.AdjustInventory(ctx, productId, delta)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.AdjustInventoryRes{Product: product.ToProto()}, nil
+}
+
 // DeleteProduct deletes Product by provided id
 func (d *dvdstoreService) DeleteProduct(ctx context.Context, req *proto.DeleteProductReq) (*proto.DeleteProductRes, error) {
 	productId := int(req.GetProductID())
-	d.log.Infof("Received DeleteProduct call with id %v", productId)
+	d.logger(ctx).Debugf("Received DeleteProduct call with id %v", productId)
 
-	if err := d.uc.DeleteProduct(productId); err != nil {
-		return nil, grpcError(err)
+	if err := d.uc.DeleteProduct(ctx, productId); err != nil {
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.DeleteProductRes{}, nil
 }
 
+// ListCategories returns all product Categories
+func (d *dvdstoreService) ListCategories(ctx context.Context, req *proto.ListCategoriesReq) (*proto.ListCategoriesRes, error) {
+	d.logger(ctx).Debug("Received ListCategories call")
+
+	categories, err := d.uc.ListCategories(ctx)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	categoriesRes := make([]*proto.Category, 0, len(categories))
+	for _, c := range categories {
+		categoriesRes = append(categoriesRes, c.ToProto())
+	}
+
+	return &proto.ListCategoriesRes{CategoryList: categoriesRes}, nil
+}
+
+// AddCategory adds passed Category and returns its id
+func (d *dvdstoreService) AddCategory(ctx context.Context, req *proto.AddCategoryReq) (*proto.AddCategoryRes, error) {
+	d.logger(ctx).Debug("Received AddCategory call")
+
+	id, err := d.uc.AddCategory(ctx, models.CategoryFromProto(req.GetCategory()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.AddCategoryRes{CategoryID: int64(id)}, nil
+}
+
 // GetOrder gets order by provided id
 func (d *dvdstoreService) GetOrder(ctx context.Context, req *proto.GetOrderReq) (*proto.GetOrderRes, error) {
 	orderId := int(req.GetOrderID())
-	d.log.Infof("Received GetOrder call with id %v", orderId)
+	d.logger(ctx).Debugf("Received GetOrder call with id %v", orderId)
 
-	order, err := d.uc.GetOrder(orderId)
+	order, err := d.uc.GetOrder(ctx, orderId)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.GetOrderRes{Order: order.ToProto()}, nil
 }
 
-// GetCustomerOrders returns customer orders by provided customer id
+// GetCustomerOrders returns page of customer orders by provided customer id
 func (d *dvdstoreService) GetCustomerOrders(ctx context.Context, req *proto.GetCustomerOrdersReq) (*proto.GetCustomerOrdersRes, error) {
 	customerId := int(req.GetCustomerID())
-	d.log.Infof("Received GetCustomerOrders call with id %v", customerId)
+	d.logger(ctx).Debugf("Received GetCustomerOrders call with id %v", customerId)
+
+	statuses := make([]models.OrderStatus, 0)
+	for _, s := range req.GetStatuses() {
+		statuses = append(statuses, models.OrderStatusFromProto(s))
+	}
+
+	page := models.PageRequest{
+		Limit:     int(req.GetLimit()),
+		SortBy:    req.GetSortBy(),
+		PageToken: req.GetPageToken(),
+	}
 
 	// Get orders
-	orders, err := d.uc.GetCustomerOrders(customerId)
+	orders, next, err := d.uc.GetCustomerOrders(ctx, customerId, statuses, page)
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	// Form response
@@ -175,13 +329,14 @@ func (d *dvdstoreService) GetCustomerOrders(ctx context.Context, req *proto.GetC
 		protoOrders = append(protoOrders, o.ToProto())
 	}
 
-	return &proto.GetCustomerOrdersRes{OrderList: protoOrders}, nil
+	return &proto.GetCustomerOrdersRes{OrderList: protoOrders, NextPageToken: next}, nil
 }
 
-// AddOrder adds order for passed customer id with provided products and returns created order id
+// AddOrder adds order for passed customer id with provided products paid with provided
+// payment method and returns created order id
 func (d *dvdstoreService) AddOrder(ctx context.Context, req *proto.AddOrderReq) (*proto.AddOrderRes, error) {
 	customerId := int(req.GetCustomerID())
-	d.log.Infof("Received AddOrder call for customer id %v", customerId)
+	d.logger(ctx).Debugf("Received AddOrder call for customer id %v", customerId)
 
 	// Form request
 	products := make([]*models.Product, 0)
@@ -189,22 +344,162 @@ func (d *dvdstoreService) AddOrder(ctx context.Context, req *proto.AddOrderReq)
 		products = append(products, models.ProductFromProto(p))
 	}
 
-	order, err := d.uc.AddOrder(customerId, products)
+	order, err := d.uc.AddOrder(ctx, customerId, products, int(req.GetPaymentMethodID()),
+		req.GetIdempotencyKey())
 	if err != nil {
-		return nil, grpcError(err)
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.AddOrderRes{OrderID: int64(order.Id)}, nil
 }
 
-// DeleteOrder deletes order with provided order id
+// CancelOrder cancels order with provided order id and returns its products to inventory
+func (d *dvdstoreService) CancelOrder(ctx context.Context, req *proto.CancelOrderReq) (*proto.CancelOrderRes, error) {
+	orderId := int(req.GetOrderID())
+	d.logger(ctx).Debugf("Received CancelOrder call with id %v", orderId)
+
+	if err := d.uc.CancelOrder(ctx, orderId); err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.CancelOrderRes{}, nil
+}
+
+// TransitionOrder moves order with provided order id to provided status
+func (d *dvdstoreService) TransitionOrder(ctx context.Context, req *proto.TransitionOrderReq) (*proto.TransitionOrderRes, error) {
+	orderId := int(req.GetOrderID())
+	status := models.OrderStatusFromProto(req.GetStatus())
+	d.logger(ctx).Debugf("Received TransitionOrder call with id %v and status %v", orderId, status)
+
+	order, err := d.uc.TransitionOrder(ctx, orderId, status)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.TransitionOrderRes{Order: order.ToProto()}, nil
+}
+
+// ReturnOrderLines records return of provided products of the order
+func (d *dvdstoreService) ReturnOrderLines(ctx context.Context, req *proto.ReturnOrderLinesReq) (*proto.ReturnOrderLinesRes, error) {
+	orderId := int(req.GetOrderID())
+	d.logger(ctx).Debugf("Received ReturnOrderLines call with id %v", orderId)
+
+	products := make([]*models.Product, 0)
+	for _, p := range req.GetProductList() {
+		products = append(products, models.ProductFromProto(p))
+	}
+
+	ret, err := d.uc.ReturnOrderLines(ctx, orderId, products)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.ReturnOrderLinesRes{Return: ret.ToProto()}, nil
+}
+
+// DeleteOrder hard-deletes order with provided order id
 func (d *dvdstoreService) DeleteOrder(ctx context.Context, req *proto.DeleteOrderReq) (*proto.DeleteOrderRes, error) {
 	orderId := int(req.GetOrderID())
-	d.log.Infof("Received DeleteOrder call with id %v", orderId)
+	d.logger(ctx).Debugf("Received DeleteOrder call with id %v", orderId)
 
-	if err := d.uc.DeleteOrder(orderId); err != nil {
-		return nil, grpcError(err)
+	if err := d.uc.DeleteOrder(ctx, orderId); err != nil {
+		return nil, grpcError(ctx, err)
 	}
 
 	return &proto.DeleteOrderRes{}, nil
 }
+
+// AddPaymentMethod registers passed card as a payment method of the customer
+func (d *dvdstoreService) AddPaymentMethod(ctx context.Context, req *proto.AddPaymentMethodReq) (*proto.AddPaymentMethodRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received AddPaymentMethod call for customer id %v", customerId)
+
+	method, err := d.uc.AddPaymentMethod(ctx, customerId, models.CardFromProto(req.GetCard()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.AddPaymentMethodRes{PaymentMethod: method.ToProto()}, nil
+}
+
+// GetPaymentMethods returns payment methods of the customer
+func (d *dvdstoreService) GetPaymentMethods(ctx context.Context, req *proto.GetPaymentMethodsReq) (*proto.GetPaymentMethodsRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received GetPaymentMethods call for customer id %v", customerId)
+
+	methods, err := d.uc.GetPaymentMethods(ctx, customerId)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	protoMethods := make([]*proto.PaymentMethod, 0, len(methods))
+	for _, m := range methods {
+		protoMethods = append(protoMethods, m.ToProto())
+	}
+
+	return &proto.GetPaymentMethodsRes{PaymentMethodList: protoMethods}, nil
+}
+
+// DeletePaymentMethod deletes payment method by provided id
+func (d *dvdstoreService) DeletePaymentMethod(ctx context.Context, req *proto.DeletePaymentMethodReq) (*proto.DeletePaymentMethodRes, error) {
+	paymentMethodId := int(req.GetPaymentMethodID())
+	d.logger(ctx).Debugf("Received DeletePaymentMethod call with id %v", paymentMethodId)
+
+	if err := d.uc.DeletePaymentMethod(ctx, paymentMethodId); err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.DeletePaymentMethodRes{}, nil
+}
+
+// GetCart returns cart of the customer
+func (d *dvdstoreService) GetCart(ctx context.Context, req *proto.GetCartReq) (*proto.GetCartRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received GetCart call for customer id %v", customerId)
+
+	cart, err := d.uc.GetCart(ctx, customerId)
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.GetCartRes{Cart: cart.ToProto()}, nil
+}
+
+// AddToCart adds quantity of the product to the customer cart
+func (d *dvdstoreService) AddToCart(ctx context.Context, req *proto.AddToCartReq) (*proto.AddToCartRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received AddToCart call for customer id %v", customerId)
+
+	cart, err := d.uc.AddToCart(ctx, customerId, int(req.GetProductID()), int(req.GetQuantity()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.AddToCartRes{Cart: cart.ToProto()}, nil
+}
+
+// RemoveFromCart removes the product from the customer cart
+func (d *dvdstoreService) RemoveFromCart(ctx context.Context, req *proto.RemoveFromCartReq) (*proto.RemoveFromCartRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received RemoveFromCart call for customer id %v", customerId)
+
+	cart, err := d.uc.RemoveFromCart(ctx, customerId, int(req.GetProductID()))
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.RemoveFromCartRes{Cart: cart.ToProto()}, nil
+}
+
+// Checkout places order of the customer cart paid with provided payment method
+func (d *dvdstoreService) Checkout(ctx context.Context, req *proto.CheckoutReq) (*proto.CheckoutRes, error) {
+	customerId := int(req.GetCustomerID())
+	d.logger(ctx).Debugf("Received Checkout call for customer id %v", customerId)
+
+	order, err := d.uc.Checkout(ctx, customerId, int(req.GetPaymentMethodID()), req.GetIdempotencyKey())
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return &proto.CheckoutRes{Order: order.ToProto()}, nil
+}
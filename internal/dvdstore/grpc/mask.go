@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// MaskFields maps update mask paths to field names of passed message. Paths are matched
+// ignoring case and underscores, so "first_name", "firstName" and "FirstName" are the same
+// field. Unknown paths are returned as is to be rejected by use case
+func MaskFields(mask *fieldmaskpb.FieldMask, msg protoreflect.ProtoMessage) []string {
+	descFields := msg.ProtoReflect().Descriptor().Fields()
+
+	fields := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		field := path
+		normalized := strings.ToLower(strings.ReplaceAll(path, "_", ""))
+		for i := 0; i < descFields.Len(); i++ {
+			name := string(descFields.Get(i).Name())
+			if strings.ToLower(name) == normalized {
+				field = name
+				break
+			}
+		}
+		fields = append(fields, field)
+	}
+
+	return fields
+}
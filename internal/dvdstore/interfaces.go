@@ -1,41 +1,156 @@
 package dvdstore
 
 import (
+	"context"
+	"time"
+
 	"github.com/alexzh7/sample-service/internal/models"
 )
 
 // PostgresRepo is used to interact via postgresql
 type PostgresRepo interface {
-	GetAllCustomers(limit int) ([]*models.Customer, error)
-	GetCustomer(customerId int) (*models.Customer, error)
-	AddCustomer(customer *models.Customer) (id int, err error)
-	DeleteCustomer(customerId int) error
+	GetAllCustomers(ctx context.Context, limit int, sortBy string,
+		after *models.Cursor) ([]*models.Customer, error)
+	GetCustomer(ctx context.Context, customerId int) (*models.Customer, error)
+	AddCustomer(ctx context.Context, customer *models.Customer, passwordHash string) (id int, err error)
+	UpdateCustomer(ctx context.Context, customer *models.Customer, fields []string) (*models.Customer, error)
+	DeleteCustomer(ctx context.Context, customerId int) error
+	GetCustomerCredentials(ctx context.Context, username string) (customerId int, passwordHash string,
+		role models.Role, err error)
+	UpdateCustomerPassword(ctx context.Context, customerId int, passwordHash string) error
+
+	GetAllProducts(ctx context.Context, limit int, sortBy string,
+		after *models.Cursor) ([]*models.Product, error)
+	SearchProducts(ctx context.Context, filter *models.ProductFilter, limit int, sortBy string,
+		after *models.Cursor) ([]*models.Product, error)
+	GetProduct(ctx context.Context, productId int) (*models.Product, error)
+	GetProductsByIds(ctx context.Context, productIds []int) ([]*models.Product, error)
+	AddProduct(ctx context.Context, prod *models.Product) (productId int, err error)
+	UpdateProduct(ctx context.Context, prod *models.Product, fields []string) (*models.Product, error)
+	AdjustInventory(ctx context.Context, productId int, delta int) (*models.Product, error)
+	DeleteProduct(ctx context.Context, productId int) error
+
+	GetAllCategories(ctx context.Context) ([]*models.Category, error)
+	GetCategory(ctx context.Context, categoryId int) (*models.Category, error)
+	AddCategory(ctx context.Context, category *models.Category) (categoryId int, err error)
 
-	GetAllProducts(limit int) ([]*models.Product, error)
-	GetProduct(productId int) (*models.Product, error)
-	AddProduct(prod *models.Product) (productId int, err error)
-	DeleteProduct(productId int) error
+	GetOrder(ctx context.Context, orderId int) (*models.Order, error)
+	GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus, limit int,
+		sortBy string, after *models.Cursor) ([]*models.Order, error)
+	AddOrder(ctx context.Context, customerId int, order *models.Order,
+		key *models.IdempotencyKey) (*models.Order, error)
+	GetIdempotencyKey(ctx context.Context, key string) (*models.IdempotencyKey, error)
+	CancelOrder(ctx context.Context, orderId int, from models.OrderStatus) error
+	UpdateOrderStatus(ctx context.Context, orderId int, from, to models.OrderStatus) error
+	UpdatePaymentStatus(ctx context.Context, paymentId int, from, to models.PaymentStatus) error
+	GetOrderReturns(ctx context.Context, orderId int) ([]*models.OrderReturn, error)
+	AddOrderReturn(ctx context.Context, ret *models.OrderReturn,
+		orderStatus models.OrderStatus) (*models.OrderReturn, error)
+	UpdateReturnRefundStatus(ctx context.Context, returnId int, from, to models.RefundStatus) error
+	DeleteOrder(ctx context.Context, orderId int) error
 
-	GetOrder(orderId int) (*models.Order, error)
-	GetCustomerOrders(customerId int) ([]*models.Order, error)
-	AddOrder(customerId int, products []*models.Product) (*models.Order, error)
-	DeleteOrder(orderId int) error
+	GetPaymentMethod(ctx context.Context, paymentMethodId int) (*models.PaymentMethod, error)
+	GetCustomerPaymentMethods(ctx context.Context, customerId int) ([]*models.PaymentMethod, error)
+	AddPaymentMethod(ctx context.Context, method *models.PaymentMethod) (*models.PaymentMethod, error)
+	DeletePaymentMethod(ctx context.Context, paymentMethodId int) error
+
+	GetCart(ctx context.Context, customerId int) (*models.Cart, error)
+	AddCartItem(ctx context.Context, customerId, productId, quantity int) error
+	RemoveCartItem(ctx context.Context, customerId, productId int) error
+	SubtractCartItems(ctx context.Context, customerId int, products []*models.Product) error
+	DeleteCart(ctx context.Context, customerId int) error
 }
 
 // Usecase is a use case for dvdstore
 type Usecase interface {
-	GetCustomers(limit int) ([]*models.Customer, error)
-	GetCustomer(customerId int) (*models.Customer, error)
-	AddCustomer(customer *models.Customer) (id int, err error)
-	DeleteCustomer(customerId int) error
-
-	GetProducts(limit int) ([]*models.Product, error)
-	GetProduct(productId int) (*models.Product, error)
-	AddProduct(prod *models.Product) (productId int, err error)
-	DeleteProduct(productId int) error
-
-	GetOrder(orderId int) (*models.Order, error)
-	GetCustomerOrders(customerId int) ([]*models.Order, error)
-	AddOrder(customerId int, products []*models.Product) (*models.Order, error)
-	DeleteOrder(orderId int) error
+	GetCustomers(ctx context.Context, page models.PageRequest) (customers []*models.Customer,
+		nextPageToken string, err error)
+	GetCustomer(ctx context.Context, customerId int) (*models.Customer, error)
+	AddCustomer(ctx context.Context, customer *models.Customer, password string) (id int, err error)
+	UpdateCustomer(ctx context.Context, customer *models.Customer, fields []string) (*models.Customer, error)
+	DeleteCustomer(ctx context.Context, customerId int) error
+	Login(ctx context.Context, credentials *models.Credentials) (token string, expiresAt time.Time, err error)
+
+	GetProducts(ctx context.Context, page models.PageRequest) (products []*models.Product,
+		nextPageToken string, err error)
+	SearchProducts(ctx context.Context, filter *models.ProductFilter,
+		page models.PageRequest) (products []*models.Product, nextPageToken string, err error)
+	GetProduct(ctx context.Context, productId int) (*models.Product, error)
+	AddProduct(ctx context.Context, prod *models.Product) (productId int, err error)
+	UpdateProduct(ctx context.Context, prod *models.Product, fields []string) (*models.Product, error)
+	AdjustInventory(ctx context.Context, productId int, delta int) (*models.Product, error)
+	DeleteProduct(ctx context.Context, productId int) error
+
+	ListCategories(ctx context.Context) ([]*models.Category, error)
+	AddCategory(ctx context.Context, category *models.Category) (categoryId int, err error)
+
+	GetOrder(ctx context.Context, orderId int) (*models.Order, error)
+	GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus,
+		page models.PageRequest) (orders []*models.Order, nextPageToken string, err error)
+	AddOrder(ctx context.Context, customerId int, products []*models.Product, paymentMethodId int,
+		idempotencyKey string) (*models.Order, error)
+	CancelOrder(ctx context.Context, orderId int) error
+	TransitionOrder(ctx context.Context, orderId int, status models.OrderStatus) (*models.Order, error)
+	ReturnOrderLines(ctx context.Context, orderId int, products []*models.Product) (*models.OrderReturn, error)
+	DeleteOrder(ctx context.Context, orderId int) error
+
+	AddPaymentMethod(ctx context.Context, customerId int, card *models.Card) (*models.PaymentMethod, error)
+	GetPaymentMethods(ctx context.Context, customerId int) ([]*models.PaymentMethod, error)
+	DeletePaymentMethod(ctx context.Context, paymentMethodId int) error
+
+	GetCart(ctx context.Context, customerId int) (*models.Cart, error)
+	AddToCart(ctx context.Context, customerId, productId, quantity int) (*models.Cart, error)
+	RemoveFromCart(ctx context.Context, customerId, productId int) (*models.Cart, error)
+	Checkout(ctx context.Context, customerId, paymentMethodId int, idempotencyKey string) (*models.Order, error)
+}
+
+// TaxCalculator calculates taxes for orders
+type TaxCalculator interface {
+	// Tax returns tax rate applied to the customer order and tax amount for ordered products.
+	// Products must have price, quantity and category fields filled
+	Tax(customer *models.Customer, products []*models.Product) (rate float64, tax models.Money)
+	// TaxAtRate returns tax amount for products at passed rate. Products must have
+	// price, quantity and category fields filled
+	TaxAtRate(rate float64, products []*models.Product) models.Money
+}
+
+// CardTokenizer exchanges payment cards for tokens, so card numbers are never stored
+type CardTokenizer interface {
+	// Tokenize returns token referencing the card. Returns ValidationError
+	// if the card is declined by the tokenizer
+	Tokenize(ctx context.Context, card *models.Card) (token string, err error)
+}
+
+// PaymentGateway moves money of payment methods. Order total is authorized before the
+// order is created, captured when the order is shipped and voided when it's cancelled.
+// Returned products are refunded from the captured payment
+type PaymentGateway interface {
+	// Authorize holds amount on the card referenced by token and returns authorization id.
+	// Returns ValidationError if the payment is declined
+	Authorize(ctx context.Context, token string, amount models.Money) (authorizationId string, err error)
+	// Capture charges the authorized amount
+	Capture(ctx context.Context, authorizationId string) error
+	// Void releases the authorized amount
+	Void(ctx context.Context, authorizationId string) error
+	// Refund returns amount of the captured payment to the customer
+	Refund(ctx context.Context, authorizationId string, amount models.Money) error
+}
+
+// OrderMetrics records business events of orders
+type OrderMetrics interface {
+	OrderPlaced(total models.Money)
+	OutOfInventory()
+}
+
+// TokenManager issues and verifies signed customer tokens
+type TokenManager interface {
+	Issue(customerId int, role models.Role) (token string, expiresAt time.Time, err error)
+	// Verify returns AuthError if token is not valid or expired
+	Verify(token string) (*models.Identity, error)
+}
+
+// APIKeys authenticates service callers by API keys
+type APIKeys interface {
+	// Verify returns AuthError if key is unknown
+	Verify(key string) (*models.Identity, error)
 }
@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetCart returns cart of the customer with items sorted by product id. Items have current
+// products with quantities in stock. Returns empty cart if customer has no cart
+func (m *memRepo) GetCart(ctx context.Context, customerId int) (*models.Cart, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cart := &models.Cart{CustomerId: customerId, Items: make([]*models.CartItem, 0)}
+	c, ok := m.carts[customerId]
+	if !ok {
+		return cart, nil
+	}
+	for productId, quantity := range c.quantities {
+		p := *m.products[productId]
+		cart.Items = append(cart.Items, &models.CartItem{Product: &p, Quantity: quantity})
+	}
+	sort.Slice(cart.Items, func(i, j int) bool { return cart.Items[i].Product.Id < cart.Items[j].Product.Id })
+	// Cart without items has no update time as in postgres
+	if len(cart.Items) > 0 {
+		cart.UpdatedAt = c.updatedAt
+	}
+	return cart, nil
+}
+
+// AddCartItem adds quantity of the product to the customer cart, creating the cart if
+// there is none, and marks the cart updated. Returns EntityError if customer or product
+// wasn't found
+func (m *memRepo) AddCartItem(ctx context.Context, customerId, productId, quantity int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.customers[customerId]; !ok {
+		return models.ErrNotFound("customer", customerId)
+	}
+	if _, ok := m.products[productId]; !ok {
+		return models.ErrNotFound("product", productId)
+	}
+
+	c, ok := m.carts[customerId]
+	if !ok {
+		c = &cart{quantities: make(map[int]int)}
+		m.carts[customerId] = c
+	}
+	c.quantities[productId] += quantity
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// RemoveCartItem removes the product from the customer cart and marks the cart updated.
+// Returns EntityError if the product is not in the cart
+func (m *memRepo) RemoveCartItem(ctx context.Context, customerId, productId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.carts[customerId]
+	if ok {
+		_, ok = c.quantities[productId]
+	}
+	if !ok {
+		return &models.EntityError{
+			Entity:  "product",
+			Message: fmt.Sprintf("id %v not found in cart of customer %v", productId, customerId),
+		}
+	}
+	delete(c.quantities, productId)
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SubtractCartItems subtracts quantities of the products from the customer cart, items with
+// no quantity left are removed. Items added to the cart concurrently are kept. Products
+// must have id and quantity fields filled
+func (m *memRepo) SubtractCartItems(ctx context.Context, customerId int, products []*models.Product) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.carts[customerId]
+	if !ok {
+		return nil
+	}
+	for _, p := range products {
+		quantity, ok := c.quantities[p.Id]
+		if !ok {
+			continue
+		}
+		if quantity <= p.Quantity {
+			delete(c.quantities, p.Id)
+			continue
+		}
+		c.quantities[p.Id] = quantity - p.Quantity
+	}
+	return nil
+}
+
+// DeleteCart deletes cart of the customer with its items
+func (m *memRepo) DeleteCart(ctx context.Context, customerId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.carts, customerId)
+	return nil
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetAllCategories returns slice of all categories sorted by id
+func (m *memRepo) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	categories := make([]*models.Category, 0, len(m.categories))
+	for _, c := range m.categories {
+		cat := *c
+		categories = append(categories, &cat)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Id < categories[j].Id })
+	return categories, nil
+}
+
+// GetCategory returns single category by given id and EntityError if category wasn't found
+func (m *memRepo) GetCategory(ctx context.Context, categoryId int) (*models.Category, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	c, ok := m.categories[categoryId]
+	if !ok {
+		return nil, models.ErrNotFound("category", categoryId)
+	}
+	cat := *c
+	return &cat, nil
+}
+
+// AddCategory adds category returning its id. Returns ConflictError if category
+// with the same name already exists
+func (m *memRepo) AddCategory(ctx context.Context, category *models.Category) (categoryId int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, c := range m.categories {
+		if strings.EqualFold(c.Name, category.Name) {
+			return 0, models.ErrAlreadyExists("category", category.Name)
+		}
+	}
+
+	m.categoryId++
+	m.categories[m.categoryId] = &models.Category{Id: m.categoryId, Name: category.Name}
+	return m.categoryId, nil
+}
@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetAllCustomers returns list of customers sorted by sortBy field that go after the cursor
+// limited by limit. Returns the first page if cursor is nil
+func (m *memRepo) GetAllCustomers(ctx context.Context, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Customer, error) {
+	if sortBy != models.SortId && sortBy != models.SortLastName {
+		return nil, fmt.Errorf("GetAllCustomers: unknown sort field %q", sortBy)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	all := make([]*customer, 0, len(m.customers))
+	keys := make([]sortKey, 0, len(m.customers))
+	for _, c := range m.customers {
+		all = append(all, c)
+		keys = append(keys, sortKey{str: c.SortValue(sortBy), id: c.Id})
+	}
+	idx, err := keysetPage(keys, after, sortBy, limit)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllCustomers: %v", err)
+	}
+
+	customers := make([]*models.Customer, 0, len(idx))
+	for _, i := range idx {
+		customers = append(customers, listedCustomer(all[i]))
+	}
+	return customers, nil
+}
+
+// listedCustomer returns fields of the customer returned by lists
+func listedCustomer(c *customer) *models.Customer {
+	return &models.Customer{Id: c.Id, FirstName: c.FirstName, LastName: c.LastName, Age: c.Age,
+		Username: c.Username}
+}
+
+// GetCustomer returns single customer by given id and EntityError if customer wasn't found
+func (m *memRepo) GetCustomer(ctx context.Context, customerId int) (*models.Customer, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	c, ok := m.customers[customerId]
+	if !ok {
+		return nil, models.ErrNotFound("customer", customerId)
+	}
+	cst := c.Customer
+	return &cst, nil
+}
+
+// AddCustomer adds a customer with password hash returning id. Returns ConflictError
+// if username is already taken
+func (m *memRepo) AddCustomer(ctx context.Context, cst *models.Customer, passwordHash string) (id int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cst.Username != "" {
+		if _, ok := m.customerByUsername(cst.Username); ok {
+			return 0, models.ErrAlreadyExists("customer", cst.Username)
+		}
+	}
+
+	m.customerId++
+	c := &customer{Customer: *cst, passwordHash: passwordHash, role: models.RoleCustomer}
+	c.Id, c.Orders = m.customerId, nil
+	m.customers[m.customerId] = c
+	return m.customerId, nil
+}
+
+// customerByUsername returns customer with username ignoring case. Must be called under lock
+func (m *memRepo) customerByUsername(username string) (*customer, bool) {
+	for _, c := range m.customers {
+		if strings.EqualFold(c.Username, username) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// UpdateCustomer updates passed customer fields and returns updated customer profile.
+// Returns EntityError if customer wasn't found
+func (m *memRepo) UpdateCustomer(ctx context.Context, cst *models.Customer,
+	fields []string) (*models.Customer, error) {
+	for _, f := range fields {
+		if !containsString(customerColumns, f) {
+			return nil, fmt.Errorf("UpdateCustomer: unknown field %q", f)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.customers[cst.Id]
+	if !ok {
+		return nil, models.ErrNotFound("customer", cst.Id)
+	}
+	for _, f := range fields {
+		switch f {
+		case "FirstName":
+			c.FirstName = cst.FirstName
+		case "LastName":
+			c.LastName = cst.LastName
+		case "Age":
+			c.Age = cst.Age
+		case "Address1":
+			c.Address1 = cst.Address1
+		case "Address2":
+			c.Address2 = cst.Address2
+		case "City":
+			c.City = cst.City
+		case "State":
+			c.State = cst.State
+		case "Zip":
+			c.Zip = cst.Zip
+		case "Country":
+			c.Country = cst.Country
+		case "Region":
+			c.Region = cst.Region
+		case "Email":
+			c.Email = cst.Email
+		case "Phone":
+			c.Phone = cst.Phone
+		case "Income":
+			c.Income = cst.Income
+		case "Gender":
+			c.Gender = cst.Gender
+		}
+	}
+	updated := c.Customer
+	return &updated, nil
+}
+
+// customerColumns lists customer fields that are stored as columns in postgres and can be updated
+var customerColumns = []string{"FirstName", "LastName", "Age", "Address1", "Address2", "City", "State",
+	"Zip", "Country", "Region", "Email", "Phone", "Income", "Gender"}
+
+// DeleteCustomer deletes customer with provided id, his payment methods and cart. Orders
+// of the customer are kept without customer id and payment method
+func (m *memRepo) DeleteCustomer(ctx context.Context, customerId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.customers, customerId)
+	delete(m.carts, customerId)
+	for _, o := range m.orders {
+		if o.customerId == customerId {
+			o.customerId = 0
+		}
+	}
+	for id, pm := range m.methods {
+		if pm.CustomerId == customerId {
+			m.deletePaymentMethod(id)
+		}
+	}
+	return nil
+}
+
+// GetCustomerCredentials returns id, password hash and role of customer with passed username.
+// Username is case-insensitive. Returns EntityError if customer wasn't found
+func (m *memRepo) GetCustomerCredentials(ctx context.Context, username string) (customerId int,
+	passwordHash string, role models.Role, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	c, ok := m.customerByUsername(username)
+	if !ok {
+		return 0, "", "", &models.EntityError{Entity: "customer", Message: fmt.Sprintf("%q not found", username)}
+	}
+	return c.Id, c.passwordHash, c.role, nil
+}
+
+// UpdateCustomerPassword replaces password hash of the customer
+func (m *memRepo) UpdateCustomerPassword(ctx context.Context, customerId int, passwordHash string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.customers[customerId]; ok {
+		c.passwordHash = passwordHash
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// customer is a stored customer with credentials
+type customer struct {
+	models.Customer
+	passwordHash string
+	role         models.Role
+}
+
+// orderline is a product, its quantity and price in the order or return
+type orderline struct {
+	productId int
+	quantity  int
+	price     models.Money
+}
+
+// order is a stored order. Customer id is 0 if customer was deleted
+type order struct {
+	models.Order
+	customerId int
+	lines      []orderline
+	returns    []*orderReturn
+}
+
+// orderReturn is a stored return of the order products
+type orderReturn struct {
+	models.OrderReturn
+	lines []orderline
+}
+
+// cart is a stored customer cart with quantities of products by product id
+type cart struct {
+	quantities map[int]int
+	updatedAt  time.Time
+}
+
+// memRepo keeps dvd store data in memory with the same semantics as postgres repository.
+// It is safe for concurrent use, every method is atomic. It implements PostgresRepo interface
+type memRepo struct {
+	mu         sync.RWMutex
+	metrics    dvdstore.OrderMetrics
+	customers  map[int]*customer
+	products   map[int]*models.Product
+	categories map[int]*models.Category
+	orders     map[int]*order
+	keys       map[string]*models.IdempotencyKey
+	methods    map[int]*models.PaymentMethod
+	// payments are shared with orders they belong to
+	payments map[int]*models.Payment
+	// carts are keyed by customer id
+	carts map[int]*cart
+
+	// Last used ids of entities
+	customerId int
+	productId  int
+	categoryId int
+	orderId    int
+	methodId   int
+	paymentId  int
+	returnId   int
+}
+
+// NewMemRepo returns empty in-memory repository. Metrics may be nil
+func NewMemRepo(metrics dvdstore.OrderMetrics) *memRepo {
+	return &memRepo{
+		metrics:    metrics,
+		customers:  make(map[int]*customer),
+		products:   make(map[int]*models.Product),
+		categories: make(map[int]*models.Category),
+		orders:     make(map[int]*order),
+		keys:       make(map[string]*models.IdempotencyKey),
+		methods:    make(map[int]*models.PaymentMethod),
+		payments:   make(map[int]*models.Payment),
+		carts:      make(map[int]*cart),
+	}
+}
+
+// sortKey is a position of the item in sorted list: value of sort field and unique id.
+// String fields are kept in str, money and time in num
+type sortKey struct {
+	str string
+	num int64
+	id  int
+}
+
+// compare returns -1, 0 or 1 if key is less, equal or greater than other key. Strings are
+// compared by bytes, the same as "C" collation used for sorting in the postgres repository
+func (k sortKey) compare(other sortKey) int {
+	switch {
+	case k.str != other.str:
+		return compareOrdered(k.str < other.str)
+	case k.num != other.num:
+		return compareOrdered(k.num < other.num)
+	case k.id != other.id:
+		return compareOrdered(k.id < other.id)
+	}
+	return 0
+}
+
+// compareOrdered returns -1 if less and 1 otherwise
+func compareOrdered(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
+
+// cursorKey returns sort key of the cursor for sortBy field
+func cursorKey(after *models.Cursor, sortBy string) (sortKey, error) {
+	key := sortKey{id: after.Id}
+	switch sortBy {
+	case models.SortTitle, models.SortLastName:
+		key.str = after.Value
+	case models.SortPrice:
+		price, err := models.ParseMoney(after.Value)
+		if err != nil {
+			return key, fmt.Errorf("cursor price: %v", err)
+		}
+		key.num = int64(price)
+	case models.SortDate:
+		date, err := time.Parse(time.RFC3339Nano, after.Value)
+		if err != nil {
+			return key, fmt.Errorf("cursor date: %v", err)
+		}
+		key.num = date.UnixNano()
+	}
+	return key, nil
+}
+
+// keysetPage sorts keys and returns indexes of keys that go after the cursor limited by
+// limit. Returns the first page if cursor is nil
+func keysetPage(keys []sortKey, after *models.Cursor, sortBy string, limit int) ([]int, error) {
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return keys[idx[i]].compare(keys[idx[j]]) < 0 })
+
+	if after != nil {
+		afterKey, err := cursorKey(after, sortBy)
+		if err != nil {
+			return nil, err
+		}
+		first := sort.Search(len(idx), func(i int) bool { return keys[idx[i]].compare(afterKey) > 0 })
+		idx = idx[first:]
+	}
+	if len(idx) > limit {
+		idx = idx[:limit]
+	}
+	return idx, nil
+}
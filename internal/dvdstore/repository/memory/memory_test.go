@@ -0,0 +1,11 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore/repository/repotest"
+)
+
+func TestConformance(t *testing.T) {
+	repotest.Run(t, NewMemRepo(nil))
+}
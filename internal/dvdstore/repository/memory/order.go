@@ -0,0 +1,275 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetOrder gets order by order id. Returns EntityError if order was not found
+func (m *memRepo) GetOrder(ctx context.Context, orderId int) (*models.Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	o, ok := m.orders[orderId]
+	if !ok {
+		return nil, models.ErrNotFound("order", orderId)
+	}
+	ord := m.orderWithProducts(o)
+	// Order without existing products is not found as in postgres
+	if len(ord.Products) == 0 {
+		return nil, models.ErrNotFound("order", orderId)
+	}
+	return ord, nil
+}
+
+// orderWithProducts returns copy of the order with products of its lines and payment. Product
+// quantity is an ordered quantity, deleted products are skipped. Must be called under lock
+func (m *memRepo) orderWithProducts(o *order) *models.Order {
+	ord := o.Order
+	if o.Payment != nil {
+		pay := *o.Payment
+		ord.Payment = &pay
+	}
+	ord.Products = m.lineProducts(o.lines)
+	return &ord
+}
+
+// lineProducts returns products of order or return lines with their quantities and prices.
+// Deleted products are skipped. Must be called under lock
+func (m *memRepo) lineProducts(lines []orderline) []*models.Product {
+	products := make([]*models.Product, 0, len(lines))
+	for _, l := range lines {
+		p, ok := m.products[l.productId]
+		if !ok {
+			continue
+		}
+		products = append(products, &models.Product{Id: p.Id, Title: p.Title, Price: l.price,
+			Quantity: l.quantity, Category: p.Category})
+	}
+	return products
+}
+
+// GetCustomerOrders gets orders for provided customer id filtered by statuses, sorted by sortBy
+// field and limited by limit. Orders in all statuses are returned if statuses are empty.
+// Only orders after the cursor are returned if cursor is not nil. Returns EntityError
+// if there are no orders for the first page
+func (m *memRepo) GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus,
+	limit int, sortBy string, after *models.Cursor) ([]*models.Order, error) {
+	if sortBy != models.SortId && sortBy != models.SortDate {
+		return nil, fmt.Errorf("GetCustomerOrders: unknown sort field %q", sortBy)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	matched := make([]*order, 0)
+	keys := make([]sortKey, 0)
+	for _, o := range m.orders {
+		if o.customerId != customerId || (len(statuses) > 0 && !containsStatus(statuses, o.Status)) {
+			continue
+		}
+		key := sortKey{id: o.Id}
+		if sortBy == models.SortDate {
+			key.num = o.Date.UnixNano()
+		}
+		matched, keys = append(matched, o), append(keys, key)
+	}
+	idx, err := keysetPage(keys, after, sortBy, limit)
+	if err != nil {
+		return nil, fmt.Errorf("GetCustomerOrders: %v", err)
+	}
+
+	orders := make([]*models.Order, 0, len(idx))
+	for _, i := range idx {
+		if ord := m.orderWithProducts(matched[i]); len(ord.Products) > 0 {
+			orders = append(orders, ord)
+		}
+	}
+
+	if len(orders) == 0 && after == nil {
+		return nil, models.ErrNotFound("orders for customer", customerId)
+	}
+	return orders, nil
+}
+
+// containsStatus reports if statuses contain status
+func containsStatus(statuses []models.OrderStatus, status models.OrderStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AddOrder creates order for customerId with products, amounts, payment method and payment
+// of passed order. Order products must have id, quantity and price fields filled, payment is
+// saved if it's not nil. Idempotency key is saved with the order if it's not nil. Returns
+// created order, EntityError if product or payment method was not found or product is out of
+// inventory and ConflictError if idempotency key is already taken by another order
+func (m *memRepo) AddOrder(ctx context.Context, customerId int, ord *models.Order,
+	key *models.IdempotencyKey) (*models.Order, error) {
+	products := ord.Products
+	sort.Sort(models.SortById(products))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Check existence, repeated product is not found as in postgres
+	for i, p := range products {
+		if _, ok := m.products[p.Id]; !ok || (i > 0 && products[i-1].Id == p.Id) {
+			return nil, &models.EntityError{
+				Message: "some of the provided products not found",
+			}
+		}
+	}
+	// Check quantity
+	for _, p := range products {
+		if p.Quantity > m.products[p.Id].Quantity {
+			if m.metrics != nil {
+				m.metrics.OutOfInventory()
+			}
+			return nil, models.ErrOutOfInventory("product", p.Id)
+		}
+	}
+	// Payment method is optional as orders column in postgres
+	if _, ok := m.methods[ord.PaymentMethodId]; ord.PaymentMethodId != 0 && !ok {
+		return nil, models.ErrNotFound("payment method", ord.PaymentMethodId)
+	}
+	// Expired key is taken over by the new order, live key is left untouched
+	if key != nil {
+		if k, ok := m.keys[key.Key]; ok && k.ExpiresAt.After(time.Now()) {
+			return nil, models.ErrAlreadyExists("idempotency key", key.Key)
+		}
+	}
+
+	// Nothing fails below, so the order is created atomically
+	m.orderId++
+	o := &order{
+		Order: models.Order{
+			Id:              m.orderId,
+			Date:            time.Now().UTC(),
+			NetAmount:       ord.NetAmount,
+			Tax:             ord.Tax,
+			TaxRate:         ord.TaxRate,
+			TotalAmount:     ord.TotalAmount,
+			Status:          models.OrderPending,
+			PaymentMethodId: ord.PaymentMethodId,
+		},
+		customerId: customerId,
+		lines:      make([]orderline, 0, len(products)),
+	}
+	for _, p := range products {
+		m.products[p.Id].Quantity -= p.Quantity
+		o.lines = append(o.lines, orderline{productId: p.Id, quantity: p.Quantity, price: p.Price})
+	}
+	if ord.Payment != nil {
+		m.paymentId++
+		pay := *ord.Payment
+		pay.Id, pay.OrderId = m.paymentId, o.Id
+		m.payments[pay.Id] = &pay
+		o.Payment = &pay
+	}
+	m.orders[o.Id] = o
+	if key != nil {
+		k := *key
+		k.OrderId = o.Id
+		m.keys[k.Key] = &k
+	}
+	if m.metrics != nil {
+		m.metrics.OrderPlaced(o.TotalAmount)
+	}
+
+	created := o.Order
+	created.Products = products
+	if o.Payment != nil {
+		pay := *o.Payment
+		created.Payment = &pay
+	}
+	return &created, nil
+}
+
+// GetIdempotencyKey returns idempotency key that has not expired yet and
+// EntityError if key wasn't found
+func (m *memRepo) GetIdempotencyKey(ctx context.Context, key string) (*models.IdempotencyKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	k, ok := m.keys[key]
+	if !ok || !k.ExpiresAt.After(time.Now()) {
+		return nil, &models.EntityError{Entity: "idempotency key", Message: "not found"}
+	}
+	found := *k
+	return &found, nil
+}
+
+// CancelOrder moves order from passed status to cancelled and returns ordered products to
+// inventory. Returns ConflictError if order is not in passed status anymore
+func (m *memRepo) CancelOrder(ctx context.Context, orderId int, from models.OrderStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.orders[orderId]
+	if !ok || o.Status != from {
+		return models.ErrStatusChanged("order", orderId)
+	}
+	o.Status = models.OrderCancelled
+
+	// Return products to inventory
+	for _, l := range o.lines {
+		if p, ok := m.products[l.productId]; ok {
+			p.Quantity += l.quantity
+		}
+	}
+	return nil
+}
+
+// UpdateOrderStatus moves order from one status to another. Returns ConflictError
+// if order is not in passed from status anymore
+func (m *memRepo) UpdateOrderStatus(ctx context.Context, orderId int, from, to models.OrderStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.orders[orderId]
+	if !ok || o.Status != from {
+		return models.ErrStatusChanged("order", orderId)
+	}
+	o.Status = to
+	return nil
+}
+
+// UpdatePaymentStatus moves payment from one status to another. Returns ConflictError
+// if payment is not in passed from status anymore
+func (m *memRepo) UpdatePaymentStatus(ctx context.Context, paymentId int, from, to models.PaymentStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pay, ok := m.payments[paymentId]
+	if !ok || pay.Status != from {
+		return models.ErrStatusChanged("payment", paymentId)
+	}
+	pay.Status = to
+	return nil
+}
+
+// DeleteOrder deletes order with its orderlines, payment and idempotency keys by given order id.
+// Ordered products are not returned to inventory
+func (m *memRepo) DeleteOrder(ctx context.Context, orderId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if o, ok := m.orders[orderId]; ok && o.Payment != nil {
+		delete(m.payments, o.Payment.Id)
+	}
+	delete(m.orders, orderId)
+	for k, key := range m.keys {
+		if key.OrderId == orderId {
+			delete(m.keys, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetPaymentMethod returns payment method by given id and EntityError if it wasn't found
+func (m *memRepo) GetPaymentMethod(ctx context.Context, paymentMethodId int) (*models.PaymentMethod, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	pm, ok := m.methods[paymentMethodId]
+	if !ok {
+		return nil, models.ErrNotFound("payment method", paymentMethodId)
+	}
+	found := *pm
+	return &found, nil
+}
+
+// GetCustomerPaymentMethods returns payment methods of the customer sorted by id
+func (m *memRepo) GetCustomerPaymentMethods(ctx context.Context, customerId int) ([]*models.PaymentMethod, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	methods := make([]*models.PaymentMethod, 0)
+	for _, pm := range m.methods {
+		if pm.CustomerId == customerId {
+			found := *pm
+			methods = append(methods, &found)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Id < methods[j].Id })
+	return methods, nil
+}
+
+// AddPaymentMethod adds payment method of the customer and returns it with id and creation
+// time. Returns EntityError if customer wasn't found
+func (m *memRepo) AddPaymentMethod(ctx context.Context, method *models.PaymentMethod) (*models.PaymentMethod, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.customers[method.CustomerId]; !ok {
+		return nil, models.ErrNotFound("customer", method.CustomerId)
+	}
+
+	m.methodId++
+	pm := *method
+	pm.Id, pm.CreatedAt = m.methodId, time.Now().UTC()
+	stored := pm
+	m.methods[pm.Id] = &stored
+	return &pm, nil
+}
+
+// DeletePaymentMethod deletes payment method with provided id. Orders paid
+// with it are kept without payment method
+func (m *memRepo) DeletePaymentMethod(ctx context.Context, paymentMethodId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.deletePaymentMethod(paymentMethodId)
+	return nil
+}
+
+// deletePaymentMethod deletes payment method and unlinks it from orders. Must be called under lock
+func (m *memRepo) deletePaymentMethod(paymentMethodId int) {
+	delete(m.methods, paymentMethodId)
+	for _, o := range m.orders {
+		if o.PaymentMethodId == paymentMethodId {
+			o.PaymentMethodId = 0
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetAllProducts returns slice of products sorted by sortBy field that go after the cursor
+// limited by limit. Returns the first page if cursor is nil
+func (m *memRepo) GetAllProducts(ctx context.Context, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Product, error) {
+	products, err := m.listProducts(limit, sortBy, after, func(*models.Product) bool { return true })
+	if err != nil {
+		return nil, fmt.Errorf("GetAllProducts: %v", err)
+	}
+	return products, nil
+}
+
+// SearchProducts returns slice of products matching the filter sorted by sortBy field
+// that go after the cursor limited by limit. Returns the first page if cursor is nil.
+// Query matches products which title contains all words of the query ignoring case
+func (m *memRepo) SearchProducts(ctx context.Context, filter *models.ProductFilter, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Product, error) {
+	words := searchWords(filter.Query)
+	match := func(p *models.Product) bool {
+		switch {
+		case len(filter.Categories) > 0 && !containsInt(filter.Categories, p.Category),
+			filter.MinPrice != nil && p.Price < *filter.MinPrice,
+			filter.MaxPrice != nil && p.Price > *filter.MaxPrice,
+			filter.InStockOnly && p.Quantity <= 0:
+			return false
+		}
+		title := searchWords(p.Title)
+		for _, w := range words {
+			if !containsString(title, w) {
+				return false
+			}
+		}
+		return true
+	}
+
+	products, err := m.listProducts(limit, sortBy, after, match)
+	if err != nil {
+		return nil, fmt.Errorf("SearchProducts: %v", err)
+	}
+	return products, nil
+}
+
+// listProducts returns page of products matching the filter function
+func (m *memRepo) listProducts(limit int, sortBy string, after *models.Cursor,
+	match func(*models.Product) bool) ([]*models.Product, error) {
+	if sortBy != models.SortId && sortBy != models.SortTitle && sortBy != models.SortPrice {
+		return nil, fmt.Errorf("unknown sort field %q", sortBy)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	matched := make([]*models.Product, 0)
+	keys := make([]sortKey, 0)
+	for _, p := range m.products {
+		if !match(p) {
+			continue
+		}
+		key := sortKey{id: p.Id}
+		switch sortBy {
+		case models.SortTitle:
+			key.str = p.Title
+		case models.SortPrice:
+			key.num = int64(p.Price)
+		}
+		matched, keys = append(matched, p), append(keys, key)
+	}
+	idx, err := keysetPage(keys, after, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*models.Product, 0, len(idx))
+	for _, i := range idx {
+		prod := *matched[i]
+		products = append(products, &prod)
+	}
+	return products, nil
+}
+
+// searchWords splits text into lower case words of letters and digits
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// GetProduct returns single product by given id and EntityError if product wasn't found
+func (m *memRepo) GetProduct(ctx context.Context, productId int) (*models.Product, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, ok := m.products[productId]
+	if !ok {
+		return nil, models.ErrNotFound("product", productId)
+	}
+	prod := *p
+	return &prod, nil
+}
+
+// GetProductsByIds returns products with provided ids sorted by id. Products
+// that were not found are skipped
+func (m *memRepo) GetProductsByIds(ctx context.Context, productIds []int) ([]*models.Product, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	products := make([]*models.Product, 0, len(productIds))
+	for id, p := range m.products {
+		if containsInt(productIds, id) {
+			prod := *p
+			products = append(products, &prod)
+		}
+	}
+	sort.Sort(models.SortById(products))
+	return products, nil
+}
+
+// AddProduct adds a product returning id
+func (m *memRepo) AddProduct(ctx context.Context, prod *models.Product) (productId int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.productId++
+	m.products[m.productId] = &models.Product{Id: m.productId, Title: prod.Title, Price: prod.Price,
+		Quantity: prod.Quantity, Category: prod.Category}
+	return m.productId, nil
+}
+
+// UpdateProduct updates passed product fields and returns updated product.
+// Returns EntityError if product wasn't found
+func (m *memRepo) UpdateProduct(ctx context.Context, prod *models.Product,
+	fields []string) (*models.Product, error) {
+	for _, f := range fields {
+		if f != "Title" && f != "Price" && f != "Category" {
+			return nil, fmt.Errorf("UpdateProduct: unknown field %q", f)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.products[prod.Id]
+	if !ok {
+		return nil, models.ErrNotFound("product", prod.Id)
+	}
+	for _, f := range fields {
+		switch f {
+		case "Title":
+			p.Title = prod.Title
+		case "Price":
+			p.Price = prod.Price
+		case "Category":
+			p.Category = prod.Category
+		}
+	}
+	updated := *p
+	return &updated, nil
+}
+
+// AdjustInventory changes product quantity in stock by delta and returns updated product.
+// Returns EntityError if product wasn't found or quantity would become negative
+func (m *memRepo) AdjustInventory(ctx context.Context, productId int, delta int) (*models.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.products[productId]
+	if !ok {
+		return nil, models.ErrNotFound("product", productId)
+	}
+	if p.Quantity+delta < 0 {
+		return nil, models.ErrOutOfInventory("product", productId)
+	}
+	p.Quantity += delta
+	prod := *p
+	return &prod, nil
+}
+
+// DeleteProduct deletes product with provided id. Orders keep their lines, but the
+// product is not returned with them anymore. The product is removed from carts
+func (m *memRepo) DeleteProduct(ctx context.Context, productId int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.products, productId)
+	// Deleted product is removed from carts as in postgres
+	for _, c := range m.carts {
+		delete(c.quantities, productId)
+	}
+	return nil
+}
+
+// containsInt reports if ints contain i
+func containsInt(ints []int, i int) bool {
+	for _, v := range ints {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString reports if strs contain str
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetOrderReturns returns returns of the order sorted by id with returned products.
+// Lines of deleted products are skipped
+func (m *memRepo) GetOrderReturns(ctx context.Context, orderId int) ([]*models.OrderReturn, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	returns := make([]*models.OrderReturn, 0)
+	o, ok := m.orders[orderId]
+	if !ok {
+		return returns, nil
+	}
+	for _, r := range o.returns {
+		ret := r.OrderReturn
+		ret.Products = m.lineProducts(r.lines)
+		// Return without existing products is skipped as in postgres
+		if len(ret.Products) > 0 {
+			returns = append(returns, &ret)
+		}
+	}
+	return returns, nil
+}
+
+// AddOrderReturn records return of the order products with pending refund and returns products
+// to inventory. Returned products must have id, price and quantity fields filled, return amounts
+// are limited by not refunded amounts of the order. Returns created return, EntityError if order
+// wasn't found and ConflictError if order is not in passed status anymore or returned quantity
+// exceeds not returned quantity of the product
+func (m *memRepo) AddOrderReturn(ctx context.Context, ret *models.OrderReturn,
+	orderStatus models.OrderStatus) (*models.OrderReturn, error) {
+	products := ret.Products
+	sort.Sort(models.SortById(products))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	o, ok := m.orders[ret.OrderId]
+	if !ok {
+		return nil, models.ErrNotFound("order", ret.OrderId)
+	}
+	if o.Status != orderStatus {
+		return nil, models.ErrStatusChanged("order", ret.OrderId)
+	}
+
+	// Check not returned quantities of products
+	notReturned := make(map[int]int)
+	for _, l := range o.lines {
+		notReturned[l.productId] += l.quantity
+	}
+	leftNet, leftTax := o.NetAmount, o.Tax
+	for _, r := range o.returns {
+		leftNet, leftTax = leftNet-r.NetAmount, leftTax-r.Tax
+		for _, l := range r.lines {
+			notReturned[l.productId] -= l.quantity
+		}
+	}
+	for _, p := range products {
+		if p.Quantity > notReturned[p.Id] {
+			return nil, models.ErrNotReturnable("product", p.Id)
+		}
+		notReturned[p.Id] -= p.Quantity
+	}
+	limited := *ret
+	limited.LimitRefund(leftNet, leftTax, returnsAll(notReturned))
+
+	// Nothing fails below, so the return is recorded atomically
+	m.returnId++
+	r := &orderReturn{
+		OrderReturn: models.OrderReturn{
+			Id:           m.returnId,
+			OrderId:      o.Id,
+			Date:         time.Now().UTC(),
+			NetAmount:    limited.NetAmount,
+			Tax:          limited.Tax,
+			TotalAmount:  limited.TotalAmount,
+			RefundStatus: models.RefundPending,
+		},
+		lines: make([]orderline, 0, len(products)),
+	}
+	for _, p := range products {
+		r.lines = append(r.lines, orderline{productId: p.Id, quantity: p.Quantity, price: p.Price})
+		if stored, ok := m.products[p.Id]; ok {
+			stored.Quantity += p.Quantity
+		}
+	}
+	o.returns = append(o.returns, r)
+
+	created := r.OrderReturn
+	created.Products = products
+	return &created, nil
+}
+
+// returnsAll reports if nothing is left to return by not returned quantities of products
+func returnsAll(notReturned map[int]int) bool {
+	for _, q := range notReturned {
+		if q > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateReturnRefundStatus moves refund of the return from one status to another. Returns
+// ConflictError if refund is not in passed from status anymore
+func (m *memRepo) UpdateReturnRefundStatus(ctx context.Context, returnId int,
+	from, to models.RefundStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, o := range m.orders {
+		for _, r := range o.returns {
+			if r.Id != returnId {
+				continue
+			}
+			if r.RefundStatus != from {
+				return models.ErrStatusChanged("return", returnId)
+			}
+			r.RefundStatus = to
+			return nil
+		}
+	}
+	return models.ErrStatusChanged("return", returnId)
+}
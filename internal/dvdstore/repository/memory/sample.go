@@ -0,0 +1,36 @@
+package memory
+
+import "github.com/alexzh7/sample-service/internal/models"
+
+// sampleCategories are categories of Dell DVD store database
+var sampleCategories = []string{"Action", "Animation", "Children", "Classics", "Comedy", "Documentary",
+	"Drama", "Family", "Foreign", "Games", "Horror", "Music", "New", "Sci-Fi", "Sports", "Travel"}
+
+// sampleProducts are products referencing sample categories by id
+var sampleProducts = []*models.Product{
+	{Title: "John Wick", Price: 1999, Quantity: 230, Category: 1},
+	{Title: "Mad Max Fury Road", Price: 1499, Quantity: 120, Category: 1},
+	{Title: "Spirited Away", Price: 1299, Quantity: 80, Category: 2},
+	{Title: "Casablanca", Price: 999, Quantity: 40, Category: 4},
+	{Title: "Groundhog Day", Price: 899, Quantity: 65, Category: 5},
+	{Title: "The Shawshank Redemption", Price: 1199, Quantity: 150, Category: 7},
+	{Title: "The Shining", Price: 1099, Quantity: 0, Category: 11},
+	{Title: "Interstellar", Price: 7999, Quantity: 60, Category: 14},
+	{Title: "Inception", Price: 12000, Quantity: 400, Category: 14},
+}
+
+// AddSampleData adds sample categories and products for local runs
+func (m *memRepo) AddSampleData() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, name := range sampleCategories {
+		m.categoryId++
+		m.categories[m.categoryId] = &models.Category{Id: m.categoryId, Name: name}
+	}
+	for _, p := range sampleProducts {
+		m.productId++
+		m.products[m.productId] = &models.Product{Id: m.productId, Title: p.Title, Price: p.Price,
+			Quantity: p.Quantity, Category: p.Category}
+	}
+}
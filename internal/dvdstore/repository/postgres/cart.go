@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+)
+
+// GetCart returns cart of the customer with items sorted by product id. Items have current
+// products with quantities in stock. Returns empty cart if customer has no cart
+func (p *pgRepo) GetCart(ctx context.Context, customerId int) (*models.Cart, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetCart", sqlGetCart)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetCart, customerId)
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("GetCart sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	cart := &models.Cart{CustomerId: customerId, Items: make([]*models.CartItem, 0)}
+	for rows.Next() {
+		var updatedAt time.Time
+		item := &models.CartItem{Product: &models.Product{}}
+		pr := item.Product
+		if err := rows.Scan(&updatedAt, &pr.Id, &pr.Title, &pr.Price, &pr.Quantity, &pr.Category,
+			&item.Quantity); err != nil {
+			return nil, fmt.Errorf("GetCart rows.Scan: %v", err)
+		}
+		cart.UpdatedAt = updatedAt
+		cart.Items = append(cart.Items, item)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return nil, fmt.Errorf("GetCart rows.Next: %v", err)
+	}
+
+	return cart, nil
+}
+
+// AddCartItem adds quantity of the product to the customer cart, creating the cart if
+// there is none, and marks the cart updated. Returns EntityError if customer or product
+// wasn't found
+func (p *pgRepo) AddCartItem(ctx context.Context, customerId, productId, quantity int) error {
+	ctx, span := startSpan(ctx, "pgRepo.AddCartItem", sqlAddCartItem)
+	defer span.End()
+
+	_, err := p.db.ExecContext(ctx, sqlAddCartItem, customerId, productId, quantity)
+	recordError(span, err)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pqForeignKeyViolation {
+			if pqErr.Constraint == "carts_customerid_fkey" {
+				return models.ErrNotFound("customer", customerId)
+			}
+			return models.ErrNotFound("product", productId)
+		}
+		return fmt.Errorf("AddCartItem sql.Exec: %v", err)
+	}
+	return nil
+}
+
+// RemoveCartItem removes the product from the customer cart and marks the cart updated.
+// Returns EntityError if the product is not in the cart
+func (p *pgRepo) RemoveCartItem(ctx context.Context, customerId, productId int) error {
+	ctx, span := startSpan(ctx, "pgRepo.RemoveCartItem", sqlRemoveCartItem)
+	defer span.End()
+
+	res, err := p.db.ExecContext(ctx, sqlRemoveCartItem, customerId, productId)
+	recordError(span, err)
+	if err != nil {
+		return fmt.Errorf("RemoveCartItem sql.Exec: %v", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("RemoveCartItem res.RowsAffected: %v", err)
+	}
+	if affected == 0 {
+		return &models.EntityError{
+			Entity:  "product",
+			Message: fmt.Sprintf("id %v not found in cart of customer %v", productId, customerId),
+		}
+	}
+	return nil
+}
+
+// SubtractCartItems subtracts quantities of the products from the customer cart, items with
+// no quantity left are removed. Items added to the cart concurrently are kept. Products
+// must have id and quantity fields filled
+func (p *pgRepo) SubtractCartItems(ctx context.Context, customerId int, products []*models.Product) error {
+	ctx, span := startSpan(ctx, "pgRepo.SubtractCartItems", "")
+	defer span.End()
+
+	productIds := make([]int, 0, len(products))
+	quantities := make([]int, 0, len(products))
+	for _, pr := range products {
+		productIds = append(productIds, pr.Id)
+		quantities = append(quantities, pr.Quantity)
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("SubtractCartItems tx.Begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, qSpan := startSpan(ctx, "SELECT carts", sqlSubtractCartItemsLockCart)
+	_, err = tx.ExecContext(ctx, sqlSubtractCartItemsLockCart, customerId)
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("SubtractCartItems tx.Exec on carts: %v", err)
+	}
+
+	_, qSpan = startSpan(ctx, "UPDATE cart_items", sqlSubtractCartItems)
+	_, err = tx.ExecContext(ctx, sqlSubtractCartItems, customerId, pq.Array(productIds), pq.Array(quantities))
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("SubtractCartItems tx.Exec on cart_items: %v", err)
+	}
+
+	_, qSpan = startSpan(ctx, "COMMIT", "")
+	err = tx.Commit()
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("SubtractCartItems tx.Commit: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteCart deletes cart of the customer with its items
+func (p *pgRepo) DeleteCart(ctx context.Context, customerId int) error {
+	ctx, span := startSpan(ctx, "pgRepo.DeleteCart", sqlDeleteCart)
+	defer span.End()
+
+	_, err := p.db.ExecContext(ctx, sqlDeleteCart, customerId)
+	recordError(span, err)
+	if err != nil {
+		return fmt.Errorf("DeleteCart sql.Exec: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCart(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	updatedAt := time.Now().UTC()
+	want := &models.Cart{CustomerId: 1, UpdatedAt: updatedAt}
+	rows := mock.NewRows([]string{"updated_at", "prod_id", "title", "price", "quan_in_stock", "category",
+		"quantity"})
+	for i, p := range mockProducts {
+		product := *p
+		want.Items = append(want.Items, &models.CartItem{Product: &product, Quantity: i + 1})
+		rows.AddRow(updatedAt, p.Id, p.Title, p.Price.String(), p.Quantity, p.Category, i+1)
+	}
+	mock.ExpectQuery("SELECT (.+) FROM carts (.+)").WithArgs(1).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetCart(context.Background(), 1)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(want, got) {
+		t.Error(NotEqualErr(want, got))
+	}
+}
+
+func TestGetCartEmpty(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM carts (.+)").WithArgs(1).
+		WillReturnRows(mock.NewRows([]string{"updated_at", "prod_id", "title", "price", "quan_in_stock",
+			"category", "quantity"}))
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetCart(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Cart{CustomerId: 1, Items: []*models.CartItem{}}, got)
+}
+
+func TestAddCartItem(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO carts (.+) INSERT INTO cart_items (.+)").WithArgs(1, 2, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.AddCartItem(context.Background(), 1, 2, 3))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddCartItemNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO carts (.+)").
+		WillReturnError(&pq.Error{Code: pqForeignKeyViolation, Constraint: "carts_customerid_fkey"})
+	mock.ExpectExec("INSERT INTO carts (.+)").
+		WillReturnError(&pq.Error{Code: pqForeignKeyViolation, Constraint: "cart_items_prod_id_fkey"})
+
+	repo := &pgRepo{db: db}
+	var e *models.EntityError
+	err := repo.AddCartItem(context.Background(), 100, 2, 1)
+	assert.ErrorAs(t, err, &e)
+	assert.Equal(t, "customer", e.Entity)
+	err = repo.AddCartItem(context.Background(), 1, 200, 1)
+	assert.ErrorAs(t, err, &e)
+	assert.Equal(t, "product", e.Entity)
+}
+
+func TestRemoveCartItem(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM cart_items (.+) UPDATE carts (.+)").WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM cart_items (.+)").WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.RemoveCartItem(context.Background(), 1, 2))
+	var e *models.EntityError
+	assert.ErrorAs(t, repo.RemoveCartItem(context.Background(), 1, 3), &e)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubtractCartItems(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT customerid FROM carts (.+) FOR UPDATE").WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM cart_items (.+) UPDATE cart_items (.+)").
+		WithArgs(1, pq.Array([]int{1, 2}), pq.Array([]int{2, 1})).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	err := repo.SubtractCartItems(context.Background(), 1, []*models.Product{{Id: 1, Quantity: 2},
+		{Id: 2, Quantity: 1}})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCart(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM carts (.+)").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.DeleteCart(context.Background(), 1))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+)
+
+// Postgres error codes of constraint violations
+const (
+	pqUniqueViolation     = "23505"
+	pqForeignKeyViolation = "23503"
+)
+
+// GetAllCategories returns slice of all categories sorted by id
+func (p *pgRepo) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetAllCategories", sqlGetAllCategories)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetAllCategories)
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllCategories sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	categories := make([]*models.Category, 0)
+	for rows.Next() {
+		cat := models.Category{}
+		if err := rows.Scan(&cat.Id, &cat.Name); err != nil {
+			return nil, fmt.Errorf("GetAllCategories rows.Scan: %v", err)
+		}
+		categories = append(categories, &cat)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return categories, fmt.Errorf("GetAllCategories rows.Next: %v", err)
+	}
+
+	return categories, nil
+}
+
+// GetCategory returns single category by given id and EntityError if category wasn't found
+func (p *pgRepo) GetCategory(ctx context.Context, categoryId int) (*models.Category, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetCategory", sqlGetCategory)
+	defer span.End()
+
+	cat := models.Category{}
+	err := p.db.QueryRowContext(ctx, sqlGetCategory, categoryId).Scan(&cat.Id, &cat.Name)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrNotFound("category", categoryId)
+		}
+		return nil, fmt.Errorf("GetCategory sql.QueryRow: %v", err)
+	}
+	return &cat, nil
+}
+
+// AddCategory adds category returning its id. Returns ConflictError if category
+// with the same name already exists
+func (p *pgRepo) AddCategory(ctx context.Context, category *models.Category) (categoryId int, err error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddCategory", sqlAddCategory)
+	defer span.End()
+
+	err = p.db.QueryRowContext(ctx, sqlAddCategory, category.Name).Scan(&categoryId)
+	recordError(span, err)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pqUniqueViolation {
+			return 0, models.ErrAlreadyExists("category", category.Name)
+		}
+		return 0, fmt.Errorf("AddCategory sql.QueryRow: %v", err)
+	}
+	return categoryId, nil
+}
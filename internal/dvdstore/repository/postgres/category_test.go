@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAllCategories(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"category", "categoryname"})
+	for _, v := range mockCategories {
+		rows.AddRow(v.Id, v.Name)
+	}
+	mock.ExpectQuery("SELECT (.+) FROM categories").WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	cats, err := repo.GetAllCategories(context.Background())
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(mockCategories, cats) {
+		t.Error(NotEqualErr(mockCategories, cats))
+	}
+}
+
+func TestGetCategory(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	cat := mockCategories[0]
+	rows := mock.NewRows([]string{"category", "categoryname"}).AddRow(cat.Id, cat.Name)
+	mock.ExpectQuery("SELECT (.+) FROM categories").WithArgs(cat.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetCategory(context.Background(), cat.Id)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(cat, got) {
+		t.Error(NotEqualErr(cat, got))
+	}
+}
+
+func TestGetCategoryNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	id := 100
+	mock.ExpectQuery("SELECT (.+) FROM categories").WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	cat, err := repo.GetCategory(context.Background(), id)
+	var e *models.EntityError
+	assert.ErrorAs(t, err, &e)
+	assert.Nil(t, cat)
+}
+
+func TestAddCategory(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	lastInsertId := 17
+	rows := mock.NewRows([]string{"category"}).AddRow(lastInsertId)
+	mock.ExpectQuery("INSERT INTO categories (.+)").WithArgs("Documentary").WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	id, err := repo.AddCategory(context.Background(), &models.Category{Name: "Documentary"})
+	assert.NoError(t, err)
+	assert.Equal(t, lastInsertId, id)
+}
+
+func TestAddCategoryDuplicate(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("INSERT INTO categories (.+)").WithArgs("Action").
+		WillReturnError(&pq.Error{Code: pqUniqueViolation})
+
+	repo := &pgRepo{db: db}
+	_, err := repo.AddCategory(context.Background(), &models.Category{Name: "Action"})
+	var e *models.ConflictError
+	assert.ErrorAs(t, err, &e)
+}
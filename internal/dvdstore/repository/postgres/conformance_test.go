@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore/repository/repotest"
+	"github.com/alexzh7/sample-service/pkg/migrate"
+	"github.com/alexzh7/sample-service/schema"
+)
+
+// TestConformance runs repository suite against Dell DVD store database passed as
+// DVDSTORE_TEST_POSTGRES connection string, e.g. "host=localhost user=pguser password=pgpass
+// dbname=dvdstore sslmode=disable". Pending migrations are applied. The test is skipped
+// if connection string is not set
+func TestConformance(t *testing.T) {
+	connStr := os.Getenv("DVDSTORE_TEST_POSTGRES")
+	if connStr == "" {
+		t.Skip("DVDSTORE_TEST_POSTGRES is not set")
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator, err := migrate.New(db, schema.Migrations)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	repo, err := NewPgRepo(db, nil)
+	require.NoError(t, err)
+	repotest.Run(t, repo)
+}
@@ -1,15 +1,37 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
 )
 
-// GetAllCustomers returns list of all customers limited by limit
-func (p *pgRepo) GetAllCustomers(limit int) ([]*models.Customer, error) {
-	rows, err := p.db.Query("SELECT customerid, firstname, lastname, age FROM customers LIMIT $1", limit)
+// customerSortColumns maps customer sort fields to table columns. Last names are compared by
+// bytes with "C" collation to keep the same order as the memory repository
+var customerSortColumns = map[string]string{
+	models.SortId:       "customerid",
+	models.SortLastName: `lastname COLLATE "C"`,
+}
+
+// GetAllCustomers returns list of customers sorted by sortBy field that go after the cursor
+// limited by limit. Returns the first page if cursor is nil
+func (p *pgRepo) GetAllCustomers(ctx context.Context, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Customer, error) {
+	sortColumn, ok := customerSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("GetAllCustomers: unknown sort field %q", sortBy)
+	}
+	where, args := keysetWhere(sortColumn, "customerid", after, 2)
+	query := fmt.Sprintf(sqlGetAllCustomers, where, keysetOrder(sortColumn, "customerid"))
+
+	ctx, span := startSpan(ctx, "pgRepo.GetAllCustomers", query)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, query, append([]interface{}{limit}, args...)...)
+	recordError(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllCustomers sql.Query: %v", err)
 	}
@@ -18,12 +40,13 @@ func (p *pgRepo) GetAllCustomers(limit int) ([]*models.Customer, error) {
 	customers := make([]*models.Customer, 0)
 	for rows.Next() {
 		cst := models.Customer{}
-		if err := rows.Scan(&cst.Id, &cst.FirstName, &cst.LastName, &cst.Age); err != nil {
+		if err := rows.Scan(&cst.Id, &cst.FirstName, &cst.LastName, &cst.Age, &cst.Username); err != nil {
 			return nil, fmt.Errorf("GetAllCustomers rows.Scan: %v", err)
 		}
 		customers = append(customers, &cst)
 	}
 	if err = rows.Err(); err != nil {
+		recordError(span, err)
 		return customers, fmt.Errorf("GetAllCustomers rows.Next: %v", err)
 	}
 
@@ -31,10 +54,13 @@ func (p *pgRepo) GetAllCustomers(limit int) ([]*models.Customer, error) {
 }
 
 // GetCustomer returns single customer by given id and EntityError if customer wasn't found
-func (p *pgRepo) GetCustomer(customerId int) (*models.Customer, error) {
+func (p *pgRepo) GetCustomer(ctx context.Context, customerId int) (*models.Customer, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetCustomer", sqlGetCustomer)
+	defer span.End()
+
 	cst := models.Customer{}
-	err := p.db.QueryRow("SELECT customerid, firstname, lastname, age FROM customers WHERE customerid=$1",
-		customerId).Scan(&cst.Id, &cst.FirstName, &cst.LastName, &cst.Age)
+	err := p.db.QueryRowContext(ctx, sqlGetCustomer, customerId).Scan(customerFields(&cst)...)
+	recordError(span, err)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, models.ErrNotFound("customer", customerId)
@@ -44,20 +70,131 @@ func (p *pgRepo) GetCustomer(customerId int) (*models.Customer, error) {
 	return &cst, nil
 }
 
-// AddCustomer adds a customer returning id
-func (p *pgRepo) AddCustomer(cst *models.Customer) (id int, err error) {
-	if err = p.db.QueryRow(sqlAddCustomer, cst.FirstName, cst.LastName, cst.Age).
-		Scan(&id); err != nil {
+// customerFields returns pointers to customer fields in order of customer profile columns
+// returned by queries
+func customerFields(cst *models.Customer) []interface{} {
+	return []interface{}{&cst.Id, &cst.FirstName, &cst.LastName, &cst.Age, &cst.Username, &cst.Address1,
+		&cst.Address2, &cst.City, &cst.State, &cst.Zip, &cst.Country, &cst.Region, &cst.Email, &cst.Phone,
+		&cst.Income, &cst.Gender}
+}
+
+// AddCustomer adds a customer with password hash returning id. Returns ConflictError
+// if username is already taken
+func (p *pgRepo) AddCustomer(ctx context.Context, cst *models.Customer, passwordHash string) (id int, err error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddCustomer", sqlAddCustomer)
+	defer span.End()
+
+	if err = p.db.QueryRowContext(ctx, sqlAddCustomer, cst.FirstName, cst.LastName, cst.Age,
+		cst.Username, passwordHash, cst.Address1, cst.Address2, cst.City, cst.State, cst.Zip, cst.Country,
+		cst.Region, cst.Email, cst.Phone, cst.Income, cst.Gender).Scan(&id); err != nil {
+		recordError(span, err)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pqUniqueViolation {
+			return 0, models.ErrAlreadyExists("customer", cst.Username)
+		}
 		return 0, fmt.Errorf("AddCustomer sql.QueryRow: %v", err)
 	}
 	return id, nil
 }
 
+// UpdateCustomer updates passed customer fields and returns updated customer profile.
+// Returns EntityError if customer wasn't found
+func (p *pgRepo) UpdateCustomer(ctx context.Context, cst *models.Customer,
+	fields []string) (*models.Customer, error) {
+	columns := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	for _, f := range fields {
+		switch f {
+		case "FirstName":
+			columns, args = append(columns, "firstname"), append(args, cst.FirstName)
+		case "LastName":
+			columns, args = append(columns, "lastname"), append(args, cst.LastName)
+		case "Age":
+			columns, args = append(columns, "age"), append(args, cst.Age)
+		case "Address1":
+			columns, args = append(columns, "address1"), append(args, cst.Address1)
+		case "Address2":
+			columns, args = append(columns, "address2"), append(args, cst.Address2)
+		case "City":
+			columns, args = append(columns, "city"), append(args, cst.City)
+		case "State":
+			columns, args = append(columns, "state"), append(args, cst.State)
+		case "Zip":
+			columns, args = append(columns, "zip"), append(args, cst.Zip)
+		case "Country":
+			columns, args = append(columns, "country"), append(args, cst.Country)
+		case "Region":
+			columns, args = append(columns, "region"), append(args, cst.Region)
+		case "Email":
+			columns, args = append(columns, "email"), append(args, cst.Email)
+		case "Phone":
+			columns, args = append(columns, "phone"), append(args, cst.Phone)
+		case "Income":
+			columns, args = append(columns, "income"), append(args, cst.Income)
+		case "Gender":
+			columns, args = append(columns, "gender"), append(args, cst.Gender)
+		default:
+			return nil, fmt.Errorf("UpdateCustomer: unknown field %q", f)
+		}
+	}
+	args = append(args, cst.Id)
+
+	query := fmt.Sprintf(sqlUpdateCustomer, setClause(columns), len(args))
+	ctx, span := startSpan(ctx, "pgRepo.UpdateCustomer", query)
+	defer span.End()
+
+	updated := models.Customer{}
+	err := p.db.QueryRowContext(ctx, query, args...).Scan(customerFields(&updated)...)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrNotFound("customer", cst.Id)
+		}
+		return nil, fmt.Errorf("UpdateCustomer sql.QueryRow: %v", err)
+	}
+	return &updated, nil
+}
+
 // DeleteCustomer deletes customer with provided id
-func (p *pgRepo) DeleteCustomer(customerId int) error {
-	_, err := p.db.Exec("DELETE FROM customers WHERE customerid=$1", customerId)
+func (p *pgRepo) DeleteCustomer(ctx context.Context, customerId int) error {
+	query := "DELETE FROM customers WHERE customerid=$1"
+	ctx, span := startSpan(ctx, "pgRepo.DeleteCustomer", query)
+	defer span.End()
+
+	_, err := p.db.ExecContext(ctx, query, customerId)
+	recordError(span, err)
 	if err != nil {
 		return fmt.Errorf("DeleteCustomer sql.Exec: %v", err)
 	}
 	return nil
 }
+
+// GetCustomerCredentials returns id, password hash and role of customer with passed username.
+// Username is case-insensitive. Returns EntityError if customer wasn't found
+func (p *pgRepo) GetCustomerCredentials(ctx context.Context, username string) (customerId int,
+	passwordHash string, role models.Role, err error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetCustomerCredentials", sqlGetCustomerCredentials)
+	defer span.End()
+
+	err = p.db.QueryRowContext(ctx, sqlGetCustomerCredentials, username).Scan(&customerId, &passwordHash, &role)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, "", "", &models.EntityError{Entity: "customer", Message: fmt.Sprintf("%q not found", username)}
+		}
+		return 0, "", "", fmt.Errorf("GetCustomerCredentials sql.QueryRow: %v", err)
+	}
+	return customerId, passwordHash, role, nil
+}
+
+// UpdateCustomerPassword replaces password hash of the customer
+func (p *pgRepo) UpdateCustomerPassword(ctx context.Context, customerId int, passwordHash string) error {
+	ctx, span := startSpan(ctx, "pgRepo.UpdateCustomerPassword", sqlUpdateCustomerPassword)
+	defer span.End()
+
+	_, err := p.db.ExecContext(ctx, sqlUpdateCustomerPassword, customerId, passwordHash)
+	recordError(span, err)
+	if err != nil {
+		return fmt.Errorf("UpdateCustomerPassword sql.Exec: %v", err)
+	}
+	return nil
+}
@@ -1,47 +1,68 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestGetAllCustomers(t *testing.T) {
 	customers := []*models.Customer{
-		{Id: 1, FirstName: "John", LastName: "Doe", Age: 40},
-		{Id: 2, FirstName: "Tony", LastName: "Stark", Age: 33},
-		{Id: 3, FirstName: "Alex", LastName: "Zhuravlev", Age: 26},
+		{Id: 1, FirstName: "John", LastName: "Doe", Age: 40, Username: "johndoe"},
+		{Id: 2, FirstName: "Tony", LastName: "Stark", Age: 33, Username: "ironman"},
+		{Id: 3, FirstName: "Alex", LastName: "Zhuravlev", Age: 26, Username: "alexzh7"},
 	}
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"customerid", "firstname", "lastname", "age"})
+	rows := mock.NewRows([]string{"customerid", "firstname", "lastname", "age", "username"})
 	for _, v := range customers {
-		rows.AddRow(v.Id, v.FirstName, v.LastName, v.Age)
+		rows.AddRow(v.Id, v.FirstName, v.LastName, v.Age, v.Username)
 	}
-	mock.ExpectQuery("SELECT (.+)").WillReturnRows(rows)
+	mock.ExpectQuery("SELECT (.+) WHERE TRUE ORDER BY customerid LIMIT").
+		WithArgs(len(customers)).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	cst, err := repo.GetAllCustomers(len(customers))
+	repo := &pgRepo{db: db}
+	cst, err := repo.GetAllCustomers(context.Background(), len(customers), models.SortId, nil)
 	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
 	if !assert.ObjectsAreEqual(customers, cst) {
 		t.Error(NotEqualErr(customers, cst))
 	}
 }
 
+func TestGetAllCustomersAfterCursor(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"customerid", "firstname", "lastname", "age", "username"}).
+		AddRow(mockCustomer.Id, mockCustomer.FirstName, mockCustomer.LastName, mockCustomer.Age,
+			mockCustomer.Username)
+	after := &models.Cursor{SortBy: models.SortLastName, Value: "Cooper", Id: 12}
+	mock.ExpectQuery(`WHERE \(lastname COLLATE "C", customerid\) > \(\$2, \$3\) ORDER BY lastname COLLATE "C", customerid`).
+		WithArgs(10, after.Value, after.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	cst, err := repo.GetAllCustomers(context.Background(), 10, models.SortLastName, after)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Len(t, cst, 1)
+}
+
 func TestGetCustomer(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"customerid", "firstname", "lastname", "age"}).
-		AddRow(mockCustomer.Id, mockCustomer.FirstName, mockCustomer.LastName, mockCustomer.Age)
+	rows := mock.NewRows(customerColumns).AddRow(customerRow(mockCustomer)...)
 	mock.ExpectQuery("SELECT (.+)").WithArgs(mockCustomer.Id).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	cst, err := repo.GetCustomer(mockCustomer.Id)
+	repo := &pgRepo{db: db}
+	cst, err := repo.GetCustomer(context.Background(), mockCustomer.Id)
 	assert.NoError(t, err)
 	if !assert.ObjectsAreEqual(mockCustomer, cst) {
 		t.Error(NotEqualErr(mockCustomer, cst))
@@ -55,8 +76,8 @@ func TestGetCustomerNotFound(t *testing.T) {
 	var id = 11
 	mock.ExpectQuery("SELECT (.+)").WithArgs(id).WillReturnError(sql.ErrNoRows)
 
-	repo := &pgRepo{db}
-	cst, err := repo.GetCustomer(id)
+	repo := &pgRepo{db: db}
+	cst, err := repo.GetCustomer(context.Background(), id)
 	var entErr *models.EntityError
 	assert.ErrorAs(t, err, &entErr)
 	assert.Nil(t, cst)
@@ -68,16 +89,105 @@ func TestAddCustomer(t *testing.T) {
 
 	var lastInsertId = 11
 	rows := mock.NewRows([]string{"customerid"}).AddRow(lastInsertId)
-	mock.ExpectQuery("INSERT (.+)").WithArgs(mockCustomer.FirstName, mockCustomer.LastName, mockCustomer.Age).
+	c := mockCustomer
+	mock.ExpectQuery("INSERT (.+)").WithArgs(c.FirstName, c.LastName, c.Age, c.Username, "hash", c.Address1,
+		c.Address2, c.City, c.State, c.Zip, c.Country, c.Region, c.Email, c.Phone, c.Income, c.Gender).
 		WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	id, err := repo.AddCustomer(mockCustomer)
+	repo := &pgRepo{db: db}
+	id, err := repo.AddCustomer(context.Background(), mockCustomer, "hash")
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
 	assert.Equal(t, lastInsertId, id)
 }
 
+func TestAddCustomerUsernameTaken(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("INSERT (.+)").WillReturnError(&pq.Error{Code: pqUniqueViolation})
+
+	repo := &pgRepo{db: db}
+	_, err := repo.AddCustomer(context.Background(), mockCustomer, "hash")
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, models.ErrAlreadyExists("customer", mockCustomer.Username), err)
+}
+
+func TestGetCustomerCredentials(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"customerid", "password", "role"}).AddRow(mockCustomer.Id, "hash", "staff")
+	mock.ExpectQuery(`SELECT (.+) WHERE lower\(username\) = lower\(\$1\)`).
+		WithArgs("JohnDoe").WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	id, hash, role, err := repo.GetCustomerCredentials(context.Background(), "JohnDoe")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, mockCustomer.Id, id)
+	assert.Equal(t, "hash", hash)
+	assert.Equal(t, models.RoleStaff, role)
+}
+
+func TestGetCustomerCredentialsNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+)").WithArgs("nobody").WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	_, _, _, err := repo.GetCustomerCredentials(context.Background(), "nobody")
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+}
+
+func TestUpdateCustomerPassword(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE customers SET password").WithArgs(mockCustomer.Id, "hash").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	err := repo.UpdateCustomerPassword(context.Background(), mockCustomer.Id, "hash")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateCustomer(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	updated := *mockCustomer
+	updated.FirstName, updated.Zip = "Jack", "90013-1234"
+	rows := mock.NewRows(customerColumns).AddRow(customerRow(&updated)...)
+	mock.ExpectQuery(`UPDATE customers SET firstname=\$1, zip=\$2 WHERE customerid = \$3`).
+		WithArgs(updated.FirstName, updated.Zip, updated.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	cst, err := repo.UpdateCustomer(context.Background(), &updated, []string{"FirstName", "Zip"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	if !assert.ObjectsAreEqual(&updated, cst) {
+		t.Error(NotEqualErr(&updated, cst))
+	}
+}
+
+func TestUpdateCustomerNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("UPDATE (.+)").WithArgs(mockCustomer.Age, mockCustomer.Id).
+		WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	cst, err := repo.UpdateCustomer(context.Background(), mockCustomer, []string{"Age"})
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+	assert.Nil(t, cst)
+}
+
 func TestDeleteCustomer(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
@@ -85,8 +195,8 @@ func TestDeleteCustomer(t *testing.T) {
 	mock.ExpectExec("DELETE (.+)").WithArgs(mockCustomer.Id).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
-	repo := &pgRepo{db}
-	err := repo.DeleteCustomer(mockCustomer.Id)
+	repo := &pgRepo{db: db}
+	err := repo.DeleteCustomer(context.Background(), mockCustomer.Id)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
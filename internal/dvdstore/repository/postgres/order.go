@@ -1,34 +1,44 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/alexzh7/sample-service/internal/models"
 	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // GetOrder gets order by order id. Returns EntityError if order was not found
-func (p *pgRepo) GetOrder(orderId int) (*models.Order, error) {
-	rows, err := p.db.Query(sqlGetOrder, orderId)
+func (p *pgRepo) GetOrder(ctx context.Context, orderId int) (*models.Order, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetOrder", sqlGetOrder)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetOrder, orderId)
+	recordError(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("GetOrder sql.Query: %v", err)
 	}
 	defer rows.Close()
 
 	ord := models.Order{}
+	pay := models.Payment{}
 	products := make([]*models.Product, 0)
 
 	for rows.Next() {
 		pr := models.Product{}
-		if err := rows.Scan(&ord.Id, &ord.Date, &ord.NetAmount, &ord.Tax, &ord.TotalAmount,
-			&pr.Id, &pr.Title, &pr.Price, &pr.Quantity); err != nil {
+		if err := rows.Scan(&ord.Id, &ord.Date, &ord.NetAmount, &ord.Tax, &ord.TaxRate,
+			&ord.TotalAmount, &ord.Status, &ord.PaymentMethodId, &pay.Id, &pay.AuthorizationId,
+			&pay.Amount, &pay.Status, &pr.Id, &pr.Title, &pr.Price, &pr.Quantity, &pr.Category); err != nil {
 			return nil, fmt.Errorf("GetOrder rows.Scan: %v", err)
 		}
 		products = append(products, &pr)
 	}
 	if err = rows.Err(); err != nil {
+		recordError(span, err)
 		return nil, fmt.Errorf("GetOrder rows.Next: %v", err)
 	}
 
@@ -37,13 +47,51 @@ func (p *pgRepo) GetOrder(orderId int) (*models.Order, error) {
 	}
 
 	ord.Products = products
+	setPayment(&ord, pay)
 
 	return &ord, nil
 }
 
-// GetCustomerOrders gets orders for provided customer id. Returns EntityError if order was not found
-func (p *pgRepo) GetCustomerOrders(customerId int) ([]*models.Order, error) {
-	rows, err := p.db.Query(sqlGetCustomerOrders, customerId)
+// setPayment sets order payment scanned from the order row. Payment id
+// is 0 if the order has no payment
+func setPayment(ord *models.Order, pay models.Payment) {
+	if pay.Id != 0 {
+		pay.OrderId = ord.Id
+		ord.Payment = &pay
+	}
+}
+
+// orderSortColumns maps order sort fields to table columns without table alias
+var orderSortColumns = map[string]string{
+	models.SortId:   "orderid",
+	models.SortDate: "orderdate",
+}
+
+// GetCustomerOrders gets orders for provided customer id filtered by statuses, sorted by sortBy
+// field and limited by limit. Orders in all statuses are returned if statuses are empty.
+// Only orders after the cursor are returned if cursor is not nil. Returns EntityError
+// if there are no orders for the first page
+func (p *pgRepo) GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus,
+	limit int, sortBy string, after *models.Cursor) ([]*models.Order, error) {
+	sortColumn, ok := orderSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("GetCustomerOrders: unknown sort field %q", sortBy)
+	}
+	statusList := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		statusList = append(statusList, string(s))
+	}
+
+	where, keysetArgs := keysetWhere("o."+sortColumn, "o.orderid", after, 4)
+	query := fmt.Sprintf(sqlGetCustomerOrders, where, keysetOrder("o."+sortColumn, "o.orderid"),
+		keysetOrder("t."+sortColumn, "t.orderid"))
+	args := append([]interface{}{customerId, pq.Array(statusList), limit}, keysetArgs...)
+
+	ctx, span := startSpan(ctx, "pgRepo.GetCustomerOrders", query)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	recordError(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("GetCustomerOrders sql.Query: %v", err)
 	}
@@ -54,13 +102,16 @@ func (p *pgRepo) GetCustomerOrders(customerId int) ([]*models.Order, error) {
 
 	for rows.Next() {
 		ord := models.Order{}
+		pay := models.Payment{}
 		pr := models.Product{}
-		if err := rows.Scan(&ord.Id, &ord.Date, &ord.NetAmount, &ord.Tax, &ord.TotalAmount,
-			&pr.Id, &pr.Title, &pr.Price, &pr.Quantity); err != nil {
+		if err := rows.Scan(&ord.Id, &ord.Date, &ord.NetAmount, &ord.Tax, &ord.TaxRate,
+			&ord.TotalAmount, &ord.Status, &ord.PaymentMethodId, &pay.Id, &pay.AuthorizationId,
+			&pay.Amount, &pay.Status, &pr.Id, &pr.Title, &pr.Price, &pr.Quantity, &pr.Category); err != nil {
 			return nil, fmt.Errorf("GetCustomerOrders rows.Scan: %v", err)
 		}
 		// Separate different orders and populate them with products
 		if id != ord.Id {
+			setPayment(&ord, pay)
 			orders = append(orders, &ord)
 			id = ord.Id
 			i++
@@ -69,39 +120,59 @@ func (p *pgRepo) GetCustomerOrders(customerId int) ([]*models.Order, error) {
 	}
 
 	if err = rows.Err(); err != nil {
+		recordError(span, err)
 		return nil, fmt.Errorf("GetCustomerOrders rows.Next: %v", err)
 	}
 
-	if len(orders) == 0 {
+	if len(orders) == 0 && after == nil {
 		return nil, models.ErrNotFound("orders for customer", customerId)
 	}
 
 	return orders, nil
 }
 
-// AddOrder creates order for customerId with provided products. Passed products must have id and
-// quantity fields filled. Returns order and EntityError if product/customer was not found or product
-// is out of inventory
-func (p *pgRepo) AddOrder(customerId int, products []*models.Product) (*models.Order, error) {
-	// Helper func
+// AddOrder creates order for customerId with products, amounts, payment method and payment
+// of passed order. Order products must have id, quantity and price fields filled, payment is
+// saved if it's not nil. Idempotency key is saved with the order if it's not nil. Returns
+// created order, EntityError if product or payment method was not found or product is out of
+// inventory and ConflictError if idempotency key is already taken by another order
+func (p *pgRepo) AddOrder(ctx context.Context, customerId int, order *models.Order,
+	key *models.IdempotencyKey) (*models.Order, error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddOrder", "")
+	defer span.End()
+
+	// Every step of transaction is traced in its own span
+	var step trace.Span
+	startStep := func(name, query string) {
+		_, step = startSpan(ctx, name, query)
+	}
+
+	// Helper func. Ends current step with the error
 	fail := func(errString string, err error) (*models.Order, error) {
+		endSpan(step, err)
+		recordError(span, err)
 		return nil, fmt.Errorf("AddOrder "+errString+": %v ", err)
 	}
 
 	// Retrieve product ids for query
+	products := order.Products
+	sort.Sort(models.SortById(products))
 	productIds := make([]int, 0)
 	for _, p := range products {
 		productIds = append(productIds, p.Id)
 	}
 
-	tx, err := p.db.Begin()
+	startStep("BEGIN", "")
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fail("tx.Begin", err)
 	}
 	defer tx.Rollback()
+	step.End()
 
-	// Check products existence and their quantity in stock
-	rows, err := tx.Query(sqlAddOrderSelectProducts, pq.Array(productIds))
+	// Lock products inventory and check their quantity in stock
+	startStep("SELECT inventory", sqlAddOrderSelectInventory)
+	rows, err := tx.QueryContext(ctx, sqlAddOrderSelectInventory, pq.Array(productIds))
 	if err != nil {
 		return fail("SELECT tx.Query", err)
 	}
@@ -110,11 +181,15 @@ func (p *pgRepo) AddOrder(customerId int, products []*models.Product) (*models.O
 	prodsInStock := make([]*models.Product, 0)
 	for rows.Next() {
 		prod := models.Product{}
-		if err = rows.Scan(&prod.Id, &prod.Quantity, &prod.Price, &prod.Title); err != nil {
+		if err = rows.Scan(&prod.Id, &prod.Quantity); err != nil {
 			return fail("SELECT inventory rows.Scan", err)
 		}
 		prodsInStock = append(prodsInStock, &prod)
 	}
+	if err = rows.Err(); err != nil {
+		return fail("SELECT inventory rows.Next", err)
+	}
+	step.End()
 
 	// Check existence
 	if len(products) != len(prodsInStock) {
@@ -122,73 +197,263 @@ func (p *pgRepo) AddOrder(customerId int, products []*models.Product) (*models.O
 			Message: "some of the provided products not found",
 		}
 	}
-	// Check quantity, add products info
-	var tax, net, total float64
-	sort.Sort(models.SortById(products))
-	for i, p := range products {
-		if p.Quantity > prodsInStock[i].Quantity {
+	// Check quantity
+	for i, prod := range products {
+		if prod.Quantity > prodsInStock[i].Quantity {
+			if p.metrics != nil {
+				p.metrics.OutOfInventory()
+			}
 			return nil, models.ErrOutOfInventory("product", prodsInStock[i].Id)
 		}
-		p.Price = prodsInStock[i].Price
-		p.Title = prodsInStock[i].Title
-		net += p.Price * float64(p.Quantity)
 	}
-	tax = net * 0.1
-	total = net + tax
 
 	// Update quantity
 	// TODO: optimize for one query
-	stmt, err := tx.Prepare("UPDATE inventory SET quan_in_stock = quan_in_stock - $1 WHERE prod_id = $2")
+	query := "UPDATE inventory SET quan_in_stock = quan_in_stock - $1 WHERE prod_id = $2"
+	startStep("UPDATE inventory", query)
+	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
 		return fail("UPDATE inventory tx.Prepare", err)
 	}
 	defer stmt.Close()
 	for _, p := range products {
-		if _, err := stmt.Exec(p.Quantity, p.Id); err != nil {
+		if _, err := stmt.ExecContext(ctx, p.Quantity, p.Id); err != nil {
 			return fail("UPDATE inventory tx.Exec", err)
 		}
 	}
+	step.End()
 
 	// Insert order
 	// Insert in orders
 	ord := &models.Order{
-		Date:        time.Now().UTC(),
-		NetAmount:   net,
-		Tax:         tax,
-		TotalAmount: total,
-		Products:    products,
+		Date:            time.Now().UTC(),
+		NetAmount:       order.NetAmount,
+		Tax:             order.Tax,
+		TaxRate:         order.TaxRate,
+		TotalAmount:     order.TotalAmount,
+		Products:        products,
+		Status:          models.OrderPending,
+		PaymentMethodId: order.PaymentMethodId,
 	}
 
-	if err = tx.QueryRow(sqlAddOrder, ord.Date, customerId, ord.NetAmount, ord.Tax, ord.TotalAmount).
-		Scan(&ord.Id); err != nil {
+	startStep("INSERT orders", sqlAddOrder)
+	if err = tx.QueryRowContext(ctx, sqlAddOrder, ord.Date, customerId, ord.NetAmount, ord.Tax, ord.TaxRate,
+		ord.TotalAmount, ord.PaymentMethodId).Scan(&ord.Id); err != nil {
+		// Payment method was deleted concurrently
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pqForeignKeyViolation {
+			endSpan(step, err)
+			return nil, models.ErrNotFound("payment method", ord.PaymentMethodId)
+		}
 		return fail("INSERT orders tx.QueryRow", err)
 	}
+	step.End()
 
 	// Insert in orderlines
-	olStmt, err := tx.Prepare(sqlAddOrderOrderlines)
+	startStep("INSERT orderlines", sqlAddOrderOrderlines)
+	olStmt, err := tx.PrepareContext(ctx, sqlAddOrderOrderlines)
 	if err != nil {
 		return fail("INSERT orderlines tx.Prepare", err)
 	}
 	defer olStmt.Close()
 	for i, p := range ord.Products {
-		if _, err := olStmt.Exec(i+1, ord.Id, p.Id, p.Quantity, ord.Date); err != nil {
+		if _, err := olStmt.ExecContext(ctx, i+1, ord.Id, p.Id, p.Quantity, ord.Date, p.Price); err != nil {
 			return fail("INSERT orderlines tx.Exec", err)
 		}
 	}
+	step.End()
+
+	// Insert in payments
+	if order.Payment != nil {
+		pay := *order.Payment
+		pay.OrderId = ord.Id
+		startStep("INSERT payments", sqlAddOrderPayment)
+		if err = tx.QueryRowContext(ctx, sqlAddOrderPayment, pay.OrderId, pay.AuthorizationId, pay.Amount,
+			pay.Status).Scan(&pay.Id); err != nil {
+			return fail("INSERT payments tx.QueryRow", err)
+		}
+		step.End()
+		ord.Payment = &pay
+	}
+
+	// Save idempotency key. Concurrent request with the same key waits here
+	// until this transaction ends and then finds the key taken
+	if key != nil {
+		startStep("INSERT order_idempotency", sqlAddOrderIdempotencyKey)
+		res, err := tx.ExecContext(ctx, sqlAddOrderIdempotencyKey, key.Key, key.RequestHash, ord.Id, key.ExpiresAt)
+		if err != nil {
+			return fail("INSERT order_idempotency tx.Exec", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fail("INSERT order_idempotency res.RowsAffected", err)
+		}
+		step.End()
+		if n == 0 {
+			return nil, models.ErrAlreadyExists("idempotency key", key.Key)
+		}
+	}
 
 	// Commit
+	startStep("COMMIT", "")
 	if err = tx.Commit(); err != nil {
 		return fail("INSERT orders tx.Commit", err)
 	}
+	step.End()
+	if p.metrics != nil {
+		p.metrics.OrderPlaced(ord.TotalAmount)
+	}
 
 	return ord, nil
 }
 
-// DeleteOrder deletes order by given order id
-func (p *pgRepo) DeleteOrder(orderId int) error {
-	_, err := p.db.Exec("DELETE FROM orders WHERE orderid=$1", orderId)
+// GetIdempotencyKey returns idempotency key that has not expired yet and
+// EntityError if key wasn't found
+func (p *pgRepo) GetIdempotencyKey(ctx context.Context, key string) (*models.IdempotencyKey, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetIdempotencyKey", sqlGetIdempotencyKey)
+	defer span.End()
+
+	k := models.IdempotencyKey{}
+	err := p.db.QueryRowContext(ctx, sqlGetIdempotencyKey, key).
+		Scan(&k.Key, &k.RequestHash, &k.OrderId, &k.ExpiresAt)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &models.EntityError{Entity: "idempotency key", Message: "not found"}
+		}
+		return nil, fmt.Errorf("GetIdempotencyKey sql.QueryRow: %v", err)
+	}
+	return &k, nil
+}
+
+// CancelOrder moves order from passed status to cancelled and returns ordered products to
+// inventory. Returns ConflictError if order is not in passed status anymore
+func (p *pgRepo) CancelOrder(ctx context.Context, orderId int, from models.OrderStatus) error {
+	ctx, span := startSpan(ctx, "pgRepo.CancelOrder", "")
+	defer span.End()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("CancelOrder tx.Begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, qSpan := startSpan(ctx, "UPDATE orders", sqlUpdateOrderStatus)
+	res, err := tx.ExecContext(ctx, sqlUpdateOrderStatus, models.OrderCancelled, orderId, from)
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("CancelOrder UPDATE orders tx.Exec: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		recordError(span, err)
+		return fmt.Errorf("CancelOrder UPDATE orders res.RowsAffected: %v", err)
+	} else if n == 0 {
+		return models.ErrStatusChanged("order", orderId)
+	}
+
+	// Return products to inventory
+	_, qSpan = startSpan(ctx, "UPDATE inventory", sqlCancelOrderRestock)
+	_, err = tx.ExecContext(ctx, sqlCancelOrderRestock, orderId)
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("CancelOrder UPDATE inventory tx.Exec: %v", err)
+	}
+
+	_, qSpan = startSpan(ctx, "COMMIT", "")
+	err = tx.Commit()
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("CancelOrder tx.Commit: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateOrderStatus moves order from one status to another. Returns ConflictError
+// if order is not in passed from status anymore
+func (p *pgRepo) UpdateOrderStatus(ctx context.Context, orderId int, from, to models.OrderStatus) error {
+	ctx, span := startSpan(ctx, "pgRepo.UpdateOrderStatus", sqlUpdateOrderStatus)
+	defer span.End()
+
+	res, err := p.db.ExecContext(ctx, sqlUpdateOrderStatus, to, orderId, from)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("UpdateOrderStatus sql.Exec: %v", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("UpdateOrderStatus res.RowsAffected: %v", err)
+	}
+	if n == 0 {
+		return models.ErrStatusChanged("order", orderId)
+	}
+	return nil
+}
+
+// UpdatePaymentStatus moves payment from one status to another. Returns ConflictError
+// if payment is not in passed from status anymore
+func (p *pgRepo) UpdatePaymentStatus(ctx context.Context, paymentId int, from, to models.PaymentStatus) error {
+	ctx, span := startSpan(ctx, "pgRepo.UpdatePaymentStatus", sqlUpdatePaymentStatus)
+	defer span.End()
+
+	res, err := p.db.ExecContext(ctx, sqlUpdatePaymentStatus, to, paymentId, from)
 	if err != nil {
-		return fmt.Errorf("DeleteOrder sql.Exec: %v", err)
+		recordError(span, err)
+		return fmt.Errorf("UpdatePaymentStatus sql.Exec: %v", err)
 	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("UpdatePaymentStatus res.RowsAffected: %v", err)
+	}
+	if n == 0 {
+		return models.ErrStatusChanged("payment", paymentId)
+	}
+	return nil
+}
+
+// DeleteOrder deletes order with its orderlines and payment by given order id. Ordered products
+// are not returned to inventory
+func (p *pgRepo) DeleteOrder(ctx context.Context, orderId int) error {
+	ctx, span := startSpan(ctx, "pgRepo.DeleteOrder", "")
+	defer span.End()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("DeleteOrder tx.Begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	query := "DELETE FROM orderlines WHERE orderid=$1"
+	_, qSpan := startSpan(ctx, "DELETE orderlines", query)
+	_, err = tx.ExecContext(ctx, query, orderId)
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("DeleteOrder tx.Exec on orderlines: %v", err)
+	}
+
+	query = "DELETE FROM orders WHERE orderid=$1"
+	_, qSpan = startSpan(ctx, "DELETE orders", query)
+	_, err = tx.ExecContext(ctx, query, orderId)
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("DeleteOrder tx.Exec on orders: %v", err)
+	}
+
+	_, qSpan = startSpan(ctx, "COMMIT", "")
+	err = tx.Commit()
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("DeleteOrder tx.Commit: %v", err)
+	}
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -8,31 +10,39 @@ import (
 	"github.com/alexzh7/sample-service/internal/models"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestGetOrder(t *testing.T) {
 	o := &models.Order{
-		Id:          1,
-		Date:        time.Now().UTC(),
-		NetAmount:   100.00,
-		Tax:         20.00,
-		TotalAmount: 120.00,
-		Products:    mockProducts,
+		Id:              1,
+		Date:            time.Now().UTC(),
+		NetAmount:       10000,
+		Tax:             2000,
+		TaxRate:         0.2,
+		TotalAmount:     12000,
+		Products:        mockProducts,
+		Status:          models.OrderPaid,
+		PaymentMethodId: 7,
+		Payment: &models.Payment{Id: 4, OrderId: 1, AuthorizationId: "auth_1", Amount: 12000,
+			Status: models.PaymentAuthorized},
 	}
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"orderid", "orderdate", "netamount", "tax", "totalamount",
-		"prod_id", "title", "price", "quantity"})
+	rows := mock.NewRows(orderColumns)
 	for _, v := range o.Products {
-		rows.AddRow(o.Id, o.Date, o.NetAmount, o.Tax, o.TotalAmount,
-			v.Id, v.Title, v.Price, v.Quantity)
+		rows.AddRow(o.Id, o.Date, o.NetAmount.String(), o.Tax.String(), o.TaxRate,
+			o.TotalAmount.String(), o.Status, o.PaymentMethodId, o.Payment.Id, o.Payment.AuthorizationId,
+			o.Payment.Amount.String(), o.Payment.Status, v.Id, v.Title, v.Price.String(), v.Quantity, v.Category)
 	}
 
 	mock.ExpectQuery("SELECT (.+)").WithArgs(o.Id).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	order, err := repo.GetOrder(o.Id)
+	repo := &pgRepo{db: db}
+	order, err := repo.GetOrder(context.Background(), o.Id)
 	assert.NoError(t, err)
 	if !assert.ObjectsAreEqual(o, order) {
 		t.Error(NotEqualErr(o, order))
@@ -46,54 +56,85 @@ func TestGetOrderNotFound(t *testing.T) {
 	rows := mock.NewRows([]string{})
 	mock.ExpectQuery("SELECT (.+)").WithArgs(10).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	order, err := repo.GetOrder(10)
+	repo := &pgRepo{db: db}
+	order, err := repo.GetOrder(context.Background(), 10)
 	var e *models.EntityError
 	assert.ErrorAs(t, err, &e)
 	assert.Nil(t, order)
 }
 
+func TestGetOrderContextCanceled(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{})
+	mock.ExpectQuery("SELECT (.+)").WithArgs(10).WillDelayFor(time.Second).WillReturnRows(rows)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	repo := &pgRepo{db: db}
+	order, err := repo.GetOrder(ctx, 10)
+	assert.Error(t, err)
+	assert.Nil(t, order)
+	assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
+
 func TestGetCustomerOrders(t *testing.T) {
 	orders := []*models.Order{
 		{
-			Id:          1,
-			Date:        time.Now().UTC(),
-			NetAmount:   100.00,
-			Tax:         20.00,
-			TotalAmount: 120.00,
-			Products:    mockProducts,
+			Id:              1,
+			Date:            time.Now().UTC(),
+			NetAmount:       10000,
+			Tax:             2000,
+			TaxRate:         0.2,
+			TotalAmount:     12000,
+			Products:        mockProducts,
+			Status:          models.OrderPending,
+			PaymentMethodId: 7,
+			Payment: &models.Payment{Id: 4, OrderId: 1, AuthorizationId: "auth_1", Amount: 12000,
+				Status: models.PaymentAuthorized},
 		},
 		{
 			Id:          2,
 			Date:        time.Now().UTC(),
-			NetAmount:   200.00,
-			Tax:         30.00,
-			TotalAmount: 230.00,
+			NetAmount:   20000,
+			Tax:         3000,
+			TaxRate:     0.15,
+			TotalAmount: 23000,
 			Products: []*models.Product{
-				{Id: 55, Title: "Marvel", Price: 90.00, Quantity: 12},
-				{Id: 78, Title: "Movie", Price: 60.00, Quantity: 5},
+				{Id: 55, Title: "Marvel", Price: 9000, Quantity: 12},
+				{Id: 78, Title: "Movie", Price: 6000, Quantity: 5},
 			},
+			Status: models.OrderPending,
 		},
 	}
 
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"orderid", "orderdate", "netamount", "tax", "totalamount",
-		"prod_id", "title", "price", "quantity"})
+	rows := mock.NewRows(orderColumns)
 
 	for _, o := range orders {
+		// Order without payment has zero payment columns
+		pay := &models.Payment{}
+		if o.Payment != nil {
+			pay = o.Payment
+		}
 		for _, p := range o.Products {
-			rows.AddRow(o.Id, o.Date, o.NetAmount, o.Tax, o.TotalAmount,
-				p.Id, p.Title, p.Price, p.Quantity)
+			rows.AddRow(o.Id, o.Date, o.NetAmount.String(), o.Tax.String(), o.TaxRate,
+				o.TotalAmount.String(), o.Status, o.PaymentMethodId, pay.Id, pay.AuthorizationId,
+				pay.Amount.String(), pay.Status, p.Id, p.Title, p.Price.String(), p.Quantity, p.Category)
 		}
 	}
 
 	var customerId = 5
-	mock.ExpectQuery("SELECT (.+)").WithArgs(customerId).WillReturnRows(rows)
+	statuses := []models.OrderStatus{models.OrderPending}
+	mock.ExpectQuery("SELECT (.+) AND TRUE ORDER BY o.orderid LIMIT (.+) ORDER BY t.orderid").
+		WithArgs(customerId, pq.Array([]string{"pending"}), 10).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	ords, err := repo.GetCustomerOrders(customerId)
+	repo := &pgRepo{db: db}
+	ords, err := repo.GetCustomerOrders(context.Background(), customerId, statuses, 10, models.SortId, nil)
 	assert.NoError(t, err)
 	if !assert.ObjectsAreEqual(orders, ords) {
 		t.Error(NotEqualErr(orders, ords))
@@ -105,28 +146,58 @@ func TestGetCustomerOrdersNotFound(t *testing.T) {
 	defer db.Close()
 
 	rows := mock.NewRows([]string{})
-	mock.ExpectQuery("SELECT (.+)").WithArgs(10).WillReturnRows(rows)
+	mock.ExpectQuery("SELECT (.+)").WithArgs(10, pq.Array([]string{}), 10).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	order, err := repo.GetCustomerOrders(10)
+	repo := &pgRepo{db: db}
+	order, err := repo.GetCustomerOrders(context.Background(), 10, nil, 10, models.SortId, nil)
 	var e *models.EntityError
 	assert.ErrorAs(t, err, &e)
 	assert.Nil(t, order)
 }
 
-func TestAddOrder(t *testing.T) {
-	customerId := 3
+func TestGetCustomerOrdersLastPage(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
 
+	rows := mock.NewRows([]string{})
+	after := &models.Cursor{SortBy: models.SortDate, Value: "2004-01-15T00:00:00Z", Id: 54}
+	mock.ExpectQuery(`AND \(o.orderdate, o.orderid\) > \(\$4, \$5\) ORDER BY o.orderdate, o.orderid`+
+		`(.+) ORDER BY t.orderdate, t.orderid`).
+		WithArgs(10, pq.Array([]string{}), 10, after.Value, after.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	orders, err := repo.GetCustomerOrders(context.Background(), 10, nil, 10, models.SortDate, after)
+	assert.NoError(t, err)
+	assert.Empty(t, orders)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectAddOrder sets expectations of AddOrder queries up to the idempotency key insert
+// and returns order to pass to AddOrder and the order it must return
+func expectAddOrder(mock sqlmock.Sqlmock, customerId int) (priced, ord *models.Order) {
+	var net models.Money
+	for _, p := range mockProducts {
+		net += p.Price.Mul(p.Quantity)
+	}
+	priced = &models.Order{
+		NetAmount:       net,
+		Tax:             net.MulRate(0.1),
+		TaxRate:         0.1,
+		TotalAmount:     net + net.MulRate(0.1),
+		Products:        mockProducts,
+		PaymentMethodId: 7,
+		Payment:         &models.Payment{AuthorizationId: "auth_1", Status: models.PaymentAuthorized},
+	}
+	priced.Payment.Amount = priced.TotalAmount
+
 	mock.ExpectBegin()
-	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock", "price", "title"})
+	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock"})
 	productIds := make([]int, 0)
 	for _, p := range mockProducts {
 		productIds = append(productIds, p.Id)
-		rows.AddRow(p.Id, p.Quantity, p.Price, p.Title)
+		rows.AddRow(p.Id, p.Quantity)
 	}
-	mock.ExpectQuery("SELECT (.+)").WithArgs(pq.Array(productIds)).
+	mock.ExpectQuery("SELECT (.+) FOR UPDATE").WithArgs(pq.Array(productIds)).
 		WillReturnRows(rows)
 
 	stmt := mock.ExpectPrepare("UPDATE (.+)")
@@ -135,37 +206,66 @@ func TestAddOrder(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
-	var tax, net, total float64
-	for _, p := range mockProducts {
-		net += p.Price * float64(p.Quantity)
-	}
-	tax = net * 0.1
-	total = net + tax
-
-	ord := &models.Order{
-		Id:          203,
-		NetAmount:   net,
-		Tax:         tax,
-		TotalAmount: total,
-		Products:    mockProducts,
+	ord = &models.Order{
+		Id:              203,
+		NetAmount:       priced.NetAmount,
+		Tax:             priced.Tax,
+		TaxRate:         priced.TaxRate,
+		TotalAmount:     priced.TotalAmount,
+		Products:        mockProducts,
+		Status:          models.OrderPending,
+		PaymentMethodId: priced.PaymentMethodId,
+		Payment: &models.Payment{Id: 11, OrderId: 203, AuthorizationId: priced.Payment.AuthorizationId,
+			Amount: priced.Payment.Amount, Status: models.PaymentAuthorized},
 	}
 
 	rows = sqlmock.NewRows([]string{"orderid"}).AddRow(ord.Id)
 	mock.ExpectQuery("INSERT (.+)").WithArgs(AnyTime{}, customerId, ord.NetAmount,
-		ord.Tax, ord.TotalAmount).WillReturnRows(rows)
+		ord.Tax, ord.TaxRate, ord.TotalAmount, ord.PaymentMethodId).WillReturnRows(rows)
 
 	stmt = mock.ExpectPrepare("INSERT (.+)")
 	for i, p := range ord.Products {
-		stmt.ExpectExec().WithArgs(i+1, ord.Id, p.Id, p.Quantity, AnyTime{}).
+		stmt.ExpectExec().WithArgs(i+1, ord.Id, p.Id, p.Quantity, AnyTime{}, p.Price).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 	}
 
+	rows = sqlmock.NewRows([]string{"id"}).AddRow(ord.Payment.Id)
+	mock.ExpectQuery("INSERT INTO payments (.+)").WithArgs(ord.Id, ord.Payment.AuthorizationId,
+		ord.Payment.Amount, ord.Payment.Status).WillReturnRows(rows)
+
+	return priced, ord
+}
+
+// orderMetrics records order events reported by repository
+type orderMetrics struct {
+	placed         int
+	revenue        models.Money
+	outOfInventory int
+}
+
+func (m *orderMetrics) OrderPlaced(total models.Money) {
+	m.placed++
+	m.revenue += total
+}
+
+func (m *orderMetrics) OutOfInventory() {
+	m.outOfInventory++
+}
+
+func TestAddOrder(t *testing.T) {
+	customerId := 3
+	db, mock := NewMock()
+	defer db.Close()
+
+	priced, ord := expectAddOrder(mock, customerId)
 	mock.ExpectCommit()
 
-	repo := &pgRepo{db}
-	order, err := repo.AddOrder(customerId, mockProducts)
+	metrics := &orderMetrics{}
+	repo := &pgRepo{db: db, metrics: metrics}
+	order, err := repo.AddOrder(context.Background(), customerId, priced, nil)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, &orderMetrics{placed: 1, revenue: priced.TotalAmount}, metrics)
 
 	ord.Date = order.Date
 	if !assert.ObjectsAreEqual(ord, order) {
@@ -173,6 +273,138 @@ func TestAddOrder(t *testing.T) {
 	}
 }
 
+func TestAddOrderPaymentMethodNotFound(t *testing.T) {
+	customerId := 3
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock"})
+	productIds := make([]int, 0)
+	for _, p := range mockProducts {
+		productIds = append(productIds, p.Id)
+		rows.AddRow(p.Id, p.Quantity)
+	}
+	mock.ExpectQuery("SELECT (.+) FOR UPDATE").WithArgs(pq.Array(productIds)).
+		WillReturnRows(rows)
+	stmt := mock.ExpectPrepare("UPDATE (.+)")
+	for _, p := range mockProducts {
+		stmt.ExpectExec().WithArgs(p.Quantity, p.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	// Payment method was deleted after the use case checked it
+	mock.ExpectQuery("INSERT (.+)").WillReturnError(&pq.Error{Code: pqForeignKeyViolation})
+	mock.ExpectRollback()
+
+	repo := &pgRepo{db: db}
+	order, err := repo.AddOrder(context.Background(), customerId,
+		&models.Order{Products: mockProducts, PaymentMethodId: 7}, nil)
+	assert.Nil(t, order)
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddOrderIdempotencyKey(t *testing.T) {
+	customerId := 3
+	db, mock := NewMock()
+	defer db.Close()
+
+	key := &models.IdempotencyKey{Key: "retry-1", RequestHash: "hash", ExpiresAt: time.Now()}
+	priced, ord := expectAddOrder(mock, customerId)
+	mock.ExpectExec("INSERT INTO order_idempotency (.+)").
+		WithArgs(key.Key, key.RequestHash, ord.Id, key.ExpiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	order, err := repo.AddOrder(context.Background(), customerId, priced, key)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, ord.Id, order.Id)
+}
+
+func TestAddOrderSpans(t *testing.T) {
+	customerId := 3
+	db, mock := NewMock()
+	defer db.Close()
+
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	priced, _ := expectAddOrder(mock, customerId)
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	_, err := repo.AddOrder(context.Background(), customerId, priced, nil)
+	assert.NoError(t, err)
+
+	spans := sr.Ended()
+	names := make([]string, 0, len(spans))
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"BEGIN", "SELECT inventory", "UPDATE inventory", "INSERT orders",
+		"INSERT orderlines", "INSERT payments", "COMMIT", "pgRepo.AddOrder"}, names)
+
+	parent := spans[len(spans)-1].SpanContext().SpanID()
+	for _, s := range spans[:len(spans)-1] {
+		assert.Equal(t, parent, s.Parent().SpanID(), s.Name())
+	}
+}
+
+func TestAddOrderIdempotencyKeyTaken(t *testing.T) {
+	customerId := 3
+	db, mock := NewMock()
+	defer db.Close()
+
+	key := &models.IdempotencyKey{Key: "retry-1", RequestHash: "hash", ExpiresAt: time.Now()}
+	priced, ord := expectAddOrder(mock, customerId)
+	mock.ExpectExec("INSERT INTO order_idempotency (.+)").
+		WithArgs(key.Key, key.RequestHash, ord.Id, key.ExpiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := &pgRepo{db: db}
+	order, err := repo.AddOrder(context.Background(), customerId, priced, key)
+	var e *models.ConflictError
+	assert.ErrorAs(t, err, &e)
+	assert.Nil(t, order)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	key := &models.IdempotencyKey{Key: "retry-1", RequestHash: "hash", OrderId: 203,
+		ExpiresAt: time.Now().UTC()}
+	rows := mock.NewRows([]string{"idempotency_key", "request_hash", "orderid", "expires_at"}).
+		AddRow(key.Key, key.RequestHash, key.OrderId, key.ExpiresAt)
+	mock.ExpectQuery("SELECT (.+) FROM order_idempotency").WithArgs(key.Key).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetIdempotencyKey(context.Background(), key.Key)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(key, got) {
+		t.Error(NotEqualErr(key, got))
+	}
+}
+
+func TestGetIdempotencyKeyNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM order_idempotency").WithArgs("expired").
+		WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	key, err := repo.GetIdempotencyKey(context.Background(), "expired")
+	var e *models.EntityError
+	assert.ErrorAs(t, err, &e)
+	assert.Nil(t, key)
+}
+
 func TestAddOrderProductNotFound(t *testing.T) {
 	customerId := 3
 	db, mock := NewMock()
@@ -185,17 +417,17 @@ func TestAddOrderProductNotFound(t *testing.T) {
 	fewProducts := mockProducts[1:2]
 
 	mock.ExpectBegin()
-	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock", "price", "title"})
+	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock"})
 	for _, f := range fewProducts {
-		rows.AddRow(f.Id, f.Quantity, f.Price, f.Title)
+		rows.AddRow(f.Id, f.Quantity)
 	}
 	mock.ExpectQuery("SELECT (.+)").WithArgs(pq.Array(productIds)).
 		WillReturnRows(rows)
 
 	mock.ExpectRollback()
 
-	repo := &pgRepo{db}
-	order, err := repo.AddOrder(customerId, mockProducts)
+	repo := &pgRepo{db: db}
+	order, err := repo.AddOrder(context.Background(), customerId, &models.Order{Products: mockProducts}, nil)
 	assert.Nil(t, order)
 	var entErr *models.EntityError
 	assert.ErrorAs(t, err, &entErr)
@@ -210,23 +442,117 @@ func TestAddOrderOutOfInventory(t *testing.T) {
 	quantity := 2
 
 	mock.ExpectBegin()
-	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock", "price", "title"})
+	rows := sqlmock.NewRows([]string{"prod_id", "quan_in_stock"})
 	productIds := make([]int, 0)
 	for _, p := range mockProducts {
 		productIds = append(productIds, p.Id)
-		rows.AddRow(p.Id, quantity, p.Price, p.Title)
+		rows.AddRow(p.Id, quantity)
 	}
 	mock.ExpectQuery("SELECT (.+)").WithArgs(pq.Array(productIds)).
 		WillReturnRows(rows)
 
 	mock.ExpectRollback()
 
-	repo := &pgRepo{db}
-	order, err := repo.AddOrder(customerId, mockProducts)
+	metrics := &orderMetrics{}
+	repo := &pgRepo{db: db, metrics: metrics}
+	order, err := repo.AddOrder(context.Background(), customerId, &models.Order{Products: mockProducts}, nil)
 	assert.Nil(t, order)
 	var entErr *models.EntityError
 	assert.ErrorAs(t, err, &entErr)
 	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, &orderMetrics{outOfInventory: 1}, metrics)
+}
+
+func TestCancelOrder(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	orderId := 10
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE orders (.+)").WithArgs(models.OrderCancelled, orderId, models.OrderPaid).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE inventory (.+)").WithArgs(orderId).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.CancelOrder(context.Background(), orderId, models.OrderPaid))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelOrderStatusChanged(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	orderId := 10
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE orders (.+)").WithArgs(models.OrderCancelled, orderId, models.OrderPaid).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := &pgRepo{db: db}
+	err := repo.CancelOrder(context.Background(), orderId, models.OrderPaid)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateOrderStatus(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	orderId := 10
+	mock.ExpectExec("UPDATE orders (.+)").WithArgs(models.OrderShipped, orderId, models.OrderPaid).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	err := repo.UpdateOrderStatus(context.Background(), orderId, models.OrderPaid, models.OrderShipped)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateOrderStatusChanged(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	orderId := 10
+	mock.ExpectExec("UPDATE orders (.+)").WithArgs(models.OrderShipped, orderId, models.OrderPaid).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := &pgRepo{db: db}
+	err := repo.UpdateOrderStatus(context.Background(), orderId, models.OrderPaid, models.OrderShipped)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePaymentStatus(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	paymentId := 4
+	mock.ExpectExec("UPDATE payments (.+)").WithArgs(models.PaymentCaptured, paymentId, models.PaymentAuthorized).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	err := repo.UpdatePaymentStatus(context.Background(), paymentId, models.PaymentAuthorized, models.PaymentCaptured)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePaymentStatusChanged(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	paymentId := 4
+	mock.ExpectExec("UPDATE payments (.+)").WithArgs(models.PaymentVoided, paymentId, models.PaymentAuthorized).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := &pgRepo{db: db}
+	err := repo.UpdatePaymentStatus(context.Background(), paymentId, models.PaymentAuthorized, models.PaymentVoided)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestDeleteOrder(t *testing.T) {
@@ -234,10 +560,14 @@ func TestDeleteOrder(t *testing.T) {
 	defer db.Close()
 
 	orderId := 10
-	mock.ExpectExec("DELETE (.+)").WithArgs(orderId).
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM orderlines (.+)").WithArgs(orderId).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM orders (.+)").WithArgs(orderId).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
-	repo := &pgRepo{db}
-	assert.NoError(t, repo.DeleteOrder(orderId))
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.DeleteOrder(context.Background(), orderId))
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+)
+
+// GetPaymentMethod returns payment method by given id and EntityError if it wasn't found
+func (p *pgRepo) GetPaymentMethod(ctx context.Context, paymentMethodId int) (*models.PaymentMethod, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetPaymentMethod", sqlGetPaymentMethod)
+	defer span.End()
+
+	pm := models.PaymentMethod{}
+	err := p.db.QueryRowContext(ctx, sqlGetPaymentMethod, paymentMethodId).Scan(&pm.Id, &pm.CustomerId,
+		&pm.Token, &pm.Brand, &pm.LastFour, &pm.ExpMonth, &pm.ExpYear, &pm.CreatedAt)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrNotFound("payment method", paymentMethodId)
+		}
+		return nil, fmt.Errorf("GetPaymentMethod sql.QueryRow: %v", err)
+	}
+	return &pm, nil
+}
+
+// GetCustomerPaymentMethods returns payment methods of the customer sorted by id
+func (p *pgRepo) GetCustomerPaymentMethods(ctx context.Context, customerId int) ([]*models.PaymentMethod, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetCustomerPaymentMethods", sqlGetCustomerPaymentMethods)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetCustomerPaymentMethods, customerId)
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("GetCustomerPaymentMethods sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	methods := make([]*models.PaymentMethod, 0)
+	for rows.Next() {
+		pm := models.PaymentMethod{}
+		if err := rows.Scan(&pm.Id, &pm.CustomerId, &pm.Token, &pm.Brand, &pm.LastFour, &pm.ExpMonth,
+			&pm.ExpYear, &pm.CreatedAt); err != nil {
+			return nil, fmt.Errorf("GetCustomerPaymentMethods rows.Scan: %v", err)
+		}
+		methods = append(methods, &pm)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return methods, fmt.Errorf("GetCustomerPaymentMethods rows.Next: %v", err)
+	}
+
+	return methods, nil
+}
+
+// AddPaymentMethod adds payment method of the customer and returns it with id and creation
+// time. Returns EntityError if customer wasn't found
+func (p *pgRepo) AddPaymentMethod(ctx context.Context, method *models.PaymentMethod) (*models.PaymentMethod, error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddPaymentMethod", sqlAddPaymentMethod)
+	defer span.End()
+
+	pm := *method
+	err := p.db.QueryRowContext(ctx, sqlAddPaymentMethod, pm.CustomerId, pm.Token, pm.Brand, pm.LastFour,
+		pm.ExpMonth, pm.ExpYear).Scan(&pm.Id, &pm.CreatedAt)
+	recordError(span, err)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pqForeignKeyViolation {
+			return nil, models.ErrNotFound("customer", pm.CustomerId)
+		}
+		return nil, fmt.Errorf("AddPaymentMethod sql.QueryRow: %v", err)
+	}
+	return &pm, nil
+}
+
+// DeletePaymentMethod deletes payment method with provided id. Orders paid
+// with it are kept without payment method
+func (p *pgRepo) DeletePaymentMethod(ctx context.Context, paymentMethodId int) error {
+	ctx, span := startSpan(ctx, "pgRepo.DeletePaymentMethod", sqlDeletePaymentMethod)
+	defer span.End()
+
+	_, err := p.db.ExecContext(ctx, sqlDeletePaymentMethod, paymentMethodId)
+	recordError(span, err)
+	if err != nil {
+		return fmt.Errorf("DeletePaymentMethod sql.Exec: %v", err)
+	}
+	return nil
+}
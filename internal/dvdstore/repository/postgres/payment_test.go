@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPaymentMethod(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	pm := mockPaymentMethods[0]
+	rows := mock.NewRows(paymentMethodColumns).AddRow(pm.Id, pm.CustomerId, pm.Token, pm.Brand,
+		pm.LastFour, pm.ExpMonth, pm.ExpYear, pm.CreatedAt)
+	mock.ExpectQuery("SELECT (.+) FROM payment_methods").WithArgs(pm.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetPaymentMethod(context.Background(), pm.Id)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(pm, got) {
+		t.Error(NotEqualErr(pm, got))
+	}
+}
+
+func TestGetPaymentMethodNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM payment_methods").WithArgs(100).WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	pm, err := repo.GetPaymentMethod(context.Background(), 100)
+	var e *models.EntityError
+	assert.ErrorAs(t, err, &e)
+	assert.Nil(t, pm)
+}
+
+func TestGetCustomerPaymentMethods(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows(paymentMethodColumns)
+	for _, pm := range mockPaymentMethods {
+		rows.AddRow(pm.Id, pm.CustomerId, pm.Token, pm.Brand, pm.LastFour, pm.ExpMonth, pm.ExpYear,
+			pm.CreatedAt)
+	}
+	mock.ExpectQuery("SELECT (.+) FROM payment_methods WHERE customerid").WithArgs(1).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	methods, err := repo.GetCustomerPaymentMethods(context.Background(), 1)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(mockPaymentMethods, methods) {
+		t.Error(NotEqualErr(mockPaymentMethods, methods))
+	}
+}
+
+func TestAddPaymentMethod(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	want := *mockPaymentMethods[0]
+	method := want
+	method.Id = 0
+	rows := mock.NewRows([]string{"id", "created_at"}).AddRow(want.Id, want.CreatedAt)
+	mock.ExpectQuery("INSERT INTO payment_methods (.+)").WithArgs(method.CustomerId, method.Token,
+		method.Brand, method.LastFour, method.ExpMonth, method.ExpYear).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.AddPaymentMethod(context.Background(), &method)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(&want, got) {
+		t.Error(NotEqualErr(&want, got))
+	}
+}
+
+func TestAddPaymentMethodCustomerNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("INSERT INTO payment_methods (.+)").
+		WillReturnError(&pq.Error{Code: pqForeignKeyViolation})
+
+	repo := &pgRepo{db: db}
+	pm, err := repo.AddPaymentMethod(context.Background(), &models.PaymentMethod{CustomerId: 100})
+	var e *models.EntityError
+	assert.ErrorAs(t, err, &e)
+	assert.Nil(t, pm)
+}
+
+func TestDeletePaymentMethod(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM payment_methods (.+)").WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &pgRepo{db: db}
+	assert.NoError(t, repo.DeletePaymentMethod(context.Background(), 1))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,15 +1,90 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/models"
 )
 
+// tracer traces repository queries
+var tracer = otel.Tracer("github.com/alexzh7/sample-service/internal/dvdstore/repository/postgres")
+
 // pgRepo implements PostgresRepo interface
 type pgRepo struct {
-	db *sql.DB
+	db      *sql.DB
+	metrics dvdstore.OrderMetrics
+}
+
+// NewPgRepo is a pgRepo constructor. Returns error if db is unreachable.
+// Metrics may be nil
+func NewPgRepo(db *sql.DB, metrics dvdstore.OrderMetrics) (*pgRepo, error) {
+	return &pgRepo{db: db, metrics: metrics}, nil
+}
+
+// startSpan starts span of repository operation. Query is recorded as span db statement
+// if it's not empty
+func startSpan(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{semconv.DBSystemPostgreSQL}
+	if query != "" {
+		attrs = append(attrs, semconv.DBStatementKey.String(query))
+	}
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// recordError records err in span unless it's nil or sql.ErrNoRows that is
+// an expected result of a query
+func recordError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// endSpan records err in span and ends it
+func endSpan(span trace.Span, err error) {
+	recordError(span, err)
+	span.End()
+}
+
+// setClause builds SET clause like "col1=$1, col2=$2" for passed columns
+func setClause(columns []string) string {
+	set := make([]string, 0, len(columns))
+	for i, c := range columns {
+		set = append(set, fmt.Sprintf("%v=$%v", c, i+1))
+	}
+	return strings.Join(set, ", ")
+}
+
+// keysetWhere builds WHERE condition for keyset pagination by sort column and unique id
+// column to return rows after the cursor. Placeholders are numbered from argN.
+// Returns always true condition if cursor is nil
+func keysetWhere(sortColumn, idColumn string, after *models.Cursor, argN int) (string, []interface{}) {
+	switch {
+	case after == nil:
+		return "TRUE", nil
+	case sortColumn == idColumn:
+		return fmt.Sprintf("%v > $%v", idColumn, argN), []interface{}{after.Id}
+	}
+	return fmt.Sprintf("(%v, %v) > ($%v, $%v)", sortColumn, idColumn, argN, argN+1),
+		[]interface{}{after.Value, after.Id}
 }
 
-// NewPgRepo is a pgRepo constructor. Returns error if db is unreachable
-func NewPgRepo(db *sql.DB) (*pgRepo, error) {
-	return &pgRepo{db: db}, nil
+// keysetOrder builds ORDER BY list for keyset pagination by sort column and unique id column
+func keysetOrder(sortColumn, idColumn string) string {
+	if sortColumn == idColumn {
+		return idColumn
+	}
+	return sortColumn + ", " + idColumn
 }
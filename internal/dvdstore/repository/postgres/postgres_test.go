@@ -21,13 +21,37 @@ var (
 	}
 
 	// Mock objects
-	mockCustomer = &models.Customer{Id: 1, FirstName: "John", LastName: "Doe", Age: 40}
-	mockProduct  = &models.Product{Id: 1, Title: "Interstellar", Price: 80.00, Quantity: 60}
+	mockCustomer = &models.Customer{Id: 1, FirstName: "John", LastName: "Doe", Age: 40,
+		Username: "johndoe", Address1: "1 Main St", City: "Los Angeles", State: "CA", Zip: "90012",
+		Country: "US", Region: 1, Email: "john@example.com", Phone: "2135550100", Income: 60000,
+		Gender: "M"}
+	// customerColumns are columns of customer profile returned by queries
+	customerColumns = []string{"customerid", "firstname", "lastname", "age", "username", "address1",
+		"address2", "city", "state", "zip", "country", "region", "email", "phone", "income", "gender"}
+	mockProduct = &models.Product{Id: 1, Title: "Interstellar", Price: 7999, Quantity: 60,
+		Category: 14}
 	mockProducts = []*models.Product{
-		{Id: 1, Title: "Interstellar", Price: 80.00, Quantity: 60},
-		{Id: 2, Title: "John Wick", Price: 100.00, Quantity: 230},
-		{Id: 3, Title: "Inception", Price: 120.00, Quantity: 400},
+		{Id: 1, Title: "Interstellar", Price: 7999, Quantity: 60, Category: 14},
+		{Id: 2, Title: "John Wick", Price: 10000, Quantity: 230, Category: 1},
+		{Id: 3, Title: "Inception", Price: 12000, Quantity: 400, Category: 14},
 	}
+	mockCategories = []*models.Category{
+		{Id: 1, Name: "Action"},
+		{Id: 14, Name: "Sci-Fi"},
+	}
+	mockPaymentMethods = []*models.PaymentMethod{
+		{Id: 1, CustomerId: 1, Token: "tok_1", Brand: models.BrandVisa, LastFour: "4242", ExpMonth: 12,
+			ExpYear: 2030, CreatedAt: time.Now().UTC()},
+		{Id: 2, CustomerId: 1, Token: "tok_2", Brand: models.BrandAmex, LastFour: "0005", ExpMonth: 3,
+			ExpYear: 2031, CreatedAt: time.Now().UTC()},
+	}
+	// paymentMethodColumns are columns of payment method returned by queries
+	paymentMethodColumns = []string{"id", "customerid", "token", "brand", "last_four", "exp_month",
+		"exp_year", "created_at"}
+	// orderColumns are columns of order with its payment and product returned by queries
+	orderColumns = []string{"orderid", "orderdate", "netamount", "tax", "taxrate", "totalamount", "status",
+		"payment_method_id", "payment_id", "authorization_id", "payment_amount", "payment_status", "prod_id",
+		"title", "price", "quantity", "category"}
 )
 
 // NewMock returns mock db connection
@@ -39,6 +63,12 @@ func NewMock() (*sql.DB, sqlmock.Sqlmock) {
 	return db, mock
 }
 
+// customerRow returns values of customer profile columns
+func customerRow(c *models.Customer) []driver.Value {
+	return []driver.Value{c.Id, c.FirstName, c.LastName, c.Age, c.Username, c.Address1, c.Address2, c.City,
+		c.State, c.Zip, c.Country, c.Region, c.Email, c.Phone, c.Income, c.Gender}
+}
+
 // AnyTime is used for matching time
 type AnyTime struct{}
 
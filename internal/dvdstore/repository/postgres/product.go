@@ -1,15 +1,38 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
 )
 
-// GetAllProducts returns slice of all products limited by limit
-func (p *pgRepo) GetAllProducts(limit int) ([]*models.Product, error) {
-	rows, err := p.db.Query(sqlGetAllProducts, limit)
+// productSortColumns maps product sort fields to table columns. Titles are compared by bytes
+// with "C" collation to keep the same order as the memory repository
+var productSortColumns = map[string]string{
+	models.SortId:    "p.prod_id",
+	models.SortTitle: `p.title COLLATE "C"`,
+	models.SortPrice: "p.price",
+}
+
+// GetAllProducts returns slice of products sorted by sortBy field that go after the cursor
+// limited by limit. Returns the first page if cursor is nil
+func (p *pgRepo) GetAllProducts(ctx context.Context, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Product, error) {
+	sortColumn, ok := productSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("GetAllProducts: unknown sort field %q", sortBy)
+	}
+	where, args := keysetWhere(sortColumn, "p.prod_id", after, 2)
+	query := fmt.Sprintf(sqlGetAllProducts, where, keysetOrder(sortColumn, "p.prod_id"))
+
+	ctx, span := startSpan(ctx, "pgRepo.GetAllProducts", query)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, query, append([]interface{}{limit}, args...)...)
+	recordError(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllProducts sql.Query: %v", err)
 	}
@@ -18,24 +41,74 @@ func (p *pgRepo) GetAllProducts(limit int) ([]*models.Product, error) {
 	products := make([]*models.Product, 0)
 	for rows.Next() {
 		prod := models.Product{}
-		if err := rows.Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity); err != nil {
+		if err := rows.Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity,
+			&prod.Category); err != nil {
 			return nil, fmt.Errorf("GetAllProducts rows.Scan: %v", err)
 		}
 		products = append(products, &prod)
 	}
 	if err = rows.Err(); err != nil {
+		recordError(span, err)
 		return products, fmt.Errorf("GetAllProducts rows.Next: %v", err)
 	}
 
 	return products, nil
 }
 
+// SearchProducts returns slice of products matching the filter sorted by sortBy field
+// that go after the cursor limited by limit. Returns the first page if cursor is nil
+func (p *pgRepo) SearchProducts(ctx context.Context, filter *models.ProductFilter, limit int, sortBy string,
+	after *models.Cursor) ([]*models.Product, error) {
+	sortColumn, ok := productSortColumns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("SearchProducts: unknown sort field %q", sortBy)
+	}
+	where, keysetArgs := keysetWhere(sortColumn, "p.prod_id", after, 7)
+	query := fmt.Sprintf(sqlSearchProducts, where, keysetOrder(sortColumn, "p.prod_id"))
+
+	categories := filter.Categories
+	if categories == nil {
+		categories = []int{}
+	}
+	args := []interface{}{limit, filter.Query, pq.Array(categories),
+		filter.MinPrice, filter.MaxPrice, filter.InStockOnly}
+
+	ctx, span := startSpan(ctx, "pgRepo.SearchProducts", query)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, query, append(args, keysetArgs...)...)
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("SearchProducts sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	products := make([]*models.Product, 0)
+	for rows.Next() {
+		prod := models.Product{}
+		if err := rows.Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity,
+			&prod.Category); err != nil {
+			return nil, fmt.Errorf("SearchProducts rows.Scan: %v", err)
+		}
+		products = append(products, &prod)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return products, fmt.Errorf("SearchProducts rows.Next: %v", err)
+	}
+
+	return products, nil
+}
+
 // GetProduct returns single product by given id and EntityError if product wasn't found
-func (p *pgRepo) GetProduct(productId int) (*models.Product, error) {
-	prod := models.Product{}
+func (p *pgRepo) GetProduct(ctx context.Context, productId int) (*models.Product, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetProduct", sqlGetProduct)
+	defer span.End()
 
-	err := p.db.QueryRow(sqlGetProduct, productId).
-		Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity)
+	prod := models.Product{}
+	err := p.db.QueryRowContext(ctx, sqlGetProduct, productId).
+		Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity, &prod.Category)
+	recordError(span, err)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, models.ErrNotFound("product", productId)
@@ -45,56 +118,176 @@ func (p *pgRepo) GetProduct(productId int) (*models.Product, error) {
 	return &prod, nil
 }
 
+// GetProductsByIds returns products with provided ids sorted by id. Products
+// that were not found are skipped
+func (p *pgRepo) GetProductsByIds(ctx context.Context, productIds []int) ([]*models.Product, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetProductsByIds", sqlGetProductsByIds)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetProductsByIds, pq.Array(productIds))
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("GetProductsByIds sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	products := make([]*models.Product, 0)
+	for rows.Next() {
+		prod := models.Product{}
+		if err := rows.Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity,
+			&prod.Category); err != nil {
+			return nil, fmt.Errorf("GetProductsByIds rows.Scan: %v", err)
+		}
+		products = append(products, &prod)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return products, fmt.Errorf("GetProductsByIds rows.Next: %v", err)
+	}
+
+	return products, nil
+}
+
 // AddProduct adds a product returning id
-func (p *pgRepo) AddProduct(prod *models.Product) (productId int, err error) {
+func (p *pgRepo) AddProduct(ctx context.Context, prod *models.Product) (productId int, err error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddProduct", "")
+	defer span.End()
+
 	// Helper func
 	fail := func(errSring string, err error) (int, error) {
+		recordError(span, err)
 		return 0, fmt.Errorf("AddProduct "+errSring+": %v", err)
 	}
 
-	tx, err := p.db.Begin()
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fail("tx.Begin", err)
 	}
 	defer tx.Rollback()
 
 	// Insert new product
-	if err = tx.QueryRow(sqlAddProduct, prod.Title, prod.Price).Scan(&productId); err != nil {
+	_, qSpan := startSpan(ctx, "INSERT products", sqlAddProduct)
+	err = tx.QueryRowContext(ctx, sqlAddProduct, prod.Category, prod.Title, prod.Price).Scan(&productId)
+	endSpan(qSpan, err)
+	if err != nil {
 		return fail("tx.Exec on products", err)
 	}
 
 	// Insert quantity
-	if _, err = tx.Exec("INSERT INTO inventory (prod_id, quan_in_stock, sales) VALUES ($1, $2, -1)",
-		productId, prod.Quantity); err != nil {
+	query := "INSERT INTO inventory (prod_id, quan_in_stock, sales) VALUES ($1, $2, -1)"
+	_, qSpan = startSpan(ctx, "INSERT inventory", query)
+	_, err = tx.ExecContext(ctx, query, productId, prod.Quantity)
+	endSpan(qSpan, err)
+	if err != nil {
 		return fail("tx.Exec on inventory", err)
 	}
 
-	if err = tx.Commit(); err != nil {
+	_, qSpan = startSpan(ctx, "COMMIT", "")
+	err = tx.Commit()
+	endSpan(qSpan, err)
+	if err != nil {
 		return fail("tx.Commit", err)
 	}
 
 	return productId, nil
 }
 
+// UpdateProduct updates passed product fields and returns updated product.
+// Returns EntityError if product wasn't found
+func (p *pgRepo) UpdateProduct(ctx context.Context, prod *models.Product,
+	fields []string) (*models.Product, error) {
+	columns := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	for _, f := range fields {
+		switch f {
+		case "Title":
+			columns, args = append(columns, "title"), append(args, prod.Title)
+		case "Price":
+			columns, args = append(columns, "price"), append(args, prod.Price)
+		case "Category":
+			columns, args = append(columns, "category"), append(args, prod.Category)
+		default:
+			return nil, fmt.Errorf("UpdateProduct: unknown field %q", f)
+		}
+	}
+	args = append(args, prod.Id)
+
+	query := fmt.Sprintf(sqlUpdateProduct, setClause(columns), len(args))
+	ctx, span := startSpan(ctx, "pgRepo.UpdateProduct", query)
+	defer span.End()
+
+	updated := models.Product{}
+	err := p.db.QueryRowContext(ctx, query, args...).
+		Scan(&updated.Id, &updated.Title, &updated.Price, &updated.Quantity, &updated.Category)
+	recordError(span, err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrNotFound("product", prod.Id)
+		}
+		return nil, fmt.Errorf("UpdateProduct sql.QueryRow: %v", err)
+	}
+	return &updated, nil
+}
+
+// AdjustInventory changes product quantity in stock by delta and returns updated product.
+// Returns EntityError if product wasn't found or quantity would become negative
+func (p *pgRepo) AdjustInventory(ctx context.Context, productId int, delta int) (*models.Product, error) {
+	ctx, span := startSpan(ctx, "pgRepo.AdjustInventory", sqlAdjustInventory)
+	defer span.End()
+
+	prod := models.Product{}
+	err := p.db.QueryRowContext(ctx, sqlAdjustInventory, delta, productId).
+		Scan(&prod.Id, &prod.Title, &prod.Price, &prod.Quantity, &prod.Category)
+	recordError(span, err)
+	if err == nil {
+		return &prod, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("AdjustInventory sql.QueryRow: %v", err)
+	}
+
+	// Nothing was updated: product is either absent or has not enough items
+	if _, err := p.GetProduct(ctx, productId); err != nil {
+		return nil, err
+	}
+	return nil, models.ErrOutOfInventory("product", productId)
+}
+
 // DeleteProduct deletes product with provided id
-func (p *pgRepo) DeleteProduct(productId int) error {
-	tx, err := p.db.Begin()
+func (p *pgRepo) DeleteProduct(ctx context.Context, productId int) error {
+	ctx, span := startSpan(ctx, "pgRepo.DeleteProduct", "")
+	defer span.End()
+
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("DeleteProduct tx.Begin: %v", err)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec("DELETE FROM inventory WHERE prod_id=$1", productId)
+	query := "DELETE FROM inventory WHERE prod_id=$1"
+	_, qSpan := startSpan(ctx, "DELETE inventory", query)
+	_, err = tx.ExecContext(ctx, query, productId)
+	endSpan(qSpan, err)
 	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("DeleteProduct tx.Exec on inventory: %v", err)
 	}
 
-	_, err = tx.Exec("DELETE FROM products WHERE prod_id=$1", productId)
+	query = "DELETE FROM products WHERE prod_id=$1"
+	_, qSpan = startSpan(ctx, "DELETE products", query)
+	_, err = tx.ExecContext(ctx, query, productId)
+	endSpan(qSpan, err)
 	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("DeleteProduct tx.Exec on products: %v", err)
 	}
 
-	if err = tx.Commit(); err != nil {
+	_, qSpan = startSpan(ctx, "COMMIT", "")
+	err = tx.Commit()
+	endSpan(qSpan, err)
+	if err != nil {
+		recordError(span, err)
 		return fmt.Errorf("DeleteProduct tx.Commit: %v", err)
 	}
 
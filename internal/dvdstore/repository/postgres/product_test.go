@@ -1,12 +1,14 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,30 +16,82 @@ func TestGetAllProducts(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock"})
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"})
 	for _, v := range mockProducts {
-		rows.AddRow(v.Id, v.Title, v.Price, v.Quantity)
+		rows.AddRow(v.Id, v.Title, v.Price.String(), v.Quantity, v.Category)
 	}
-	mock.ExpectQuery("SELECT (.+)").WillReturnRows(rows)
+	mock.ExpectQuery("SELECT (.+) WHERE TRUE ORDER BY p.prod_id LIMIT").
+		WithArgs(len(mockProducts)).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	prods, err := repo.GetAllProducts(len(mockProducts))
+	repo := &pgRepo{db: db}
+	prods, err := repo.GetAllProducts(context.Background(), len(mockProducts), models.SortId, nil)
 	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
 	if !assert.ObjectsAreEqual(mockProducts, prods) {
 		t.Error(NotEqualErr(mockProducts, prods))
 	}
 }
 
+func TestGetAllProductsAfterCursor(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"})
+	for _, v := range mockProducts[1:] {
+		rows.AddRow(v.Id, v.Title, v.Price.String(), v.Quantity, v.Category)
+	}
+	after := &models.Cursor{SortBy: models.SortPrice, Value: mockProducts[0].Price.String(),
+		Id: mockProducts[0].Id}
+	mock.ExpectQuery(`WHERE \(p.price, p.prod_id\) > \(\$2, \$3\) ORDER BY p.price, p.prod_id`).
+		WithArgs(2, after.Value, after.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	prods, err := repo.GetAllProducts(context.Background(), 2, models.SortPrice, after)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	if !assert.ObjectsAreEqual(mockProducts[1:], prods) {
+		t.Error(NotEqualErr(mockProducts[1:], prods))
+	}
+}
+
+func TestSearchProducts(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"}).
+		AddRow(mockProduct.Id, mockProduct.Title, mockProduct.Price.String(), mockProduct.Quantity,
+			mockProduct.Category)
+	minPrice := models.Money(500)
+	filter := &models.ProductFilter{
+		Query:       "academy",
+		Categories:  []int{3, 5},
+		MinPrice:    &minPrice,
+		InStockOnly: true,
+	}
+	mock.ExpectQuery(`p.search @@ plainto_tsquery(.+) AND TRUE ORDER BY p.title COLLATE "C", p.prod_id LIMIT`).
+		WithArgs(10, "academy", pq.Array([]int{3, 5}), minPrice.String(), nil, true).
+		WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	prods, err := repo.SearchProducts(context.Background(), filter, 10, models.SortTitle, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	if !assert.ObjectsAreEqual([]*models.Product{mockProduct}, prods) {
+		t.Error(NotEqualErr([]*models.Product{mockProduct}, prods))
+	}
+}
+
 func TestGetProduct(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
 
-	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock"}).
-		AddRow(mockProduct.Id, mockProduct.Title, mockProduct.Price, mockProduct.Quantity)
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"}).
+		AddRow(mockProduct.Id, mockProduct.Title, mockProduct.Price.String(), mockProduct.Quantity,
+			mockProduct.Category)
 	mock.ExpectQuery("SELECT (.+)").WithArgs(mockProduct.Id).WillReturnRows(rows)
 
-	repo := &pgRepo{db}
-	pr, err := repo.GetProduct(mockProduct.Id)
+	repo := &pgRepo{db: db}
+	pr, err := repo.GetProduct(context.Background(), mockProduct.Id)
 	assert.NoError(t, err)
 	if !assert.ObjectsAreEqual(mockProduct, pr) {
 		t.Error(NotEqualErr(mockProduct, pr))
@@ -51,13 +105,33 @@ func TestGetProductNotFound(t *testing.T) {
 	var id = 11
 	mock.ExpectQuery("SELECT (.+)").WithArgs(id).WillReturnError(sql.ErrNoRows)
 
-	repo := &pgRepo{db}
-	pr, err := repo.GetProduct(id)
+	repo := &pgRepo{db: db}
+	pr, err := repo.GetProduct(context.Background(), id)
 	var entErr *models.EntityError
 	assert.ErrorAs(t, err, &entErr)
 	assert.Nil(t, pr)
 }
 
+func TestGetProductsByIds(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	productIds := make([]int, 0)
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"})
+	for _, v := range mockProducts {
+		productIds = append(productIds, v.Id)
+		rows.AddRow(v.Id, v.Title, v.Price.String(), v.Quantity, v.Category)
+	}
+	mock.ExpectQuery("SELECT (.+)").WithArgs(pq.Array(productIds)).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	prods, err := repo.GetProductsByIds(context.Background(), productIds)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(mockProducts, prods) {
+		t.Error(NotEqualErr(mockProducts, prods))
+	}
+}
+
 func TestAddProduct(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
@@ -65,15 +139,16 @@ func TestAddProduct(t *testing.T) {
 	mock.ExpectBegin()
 	var lastInsertId int = 11
 	rows := sqlmock.NewRows([]string{"prod_id"}).AddRow(lastInsertId)
-	mock.ExpectQuery("INSERT INTO products (.+)").WithArgs(mockProduct.Title, mockProduct.Price).
+	mock.ExpectQuery("INSERT INTO products (.+)").
+		WithArgs(mockProduct.Category, mockProduct.Title, mockProduct.Price).
 		WillReturnRows(rows)
 
 	mock.ExpectExec("INSERT INTO inventory (.+)").WithArgs(lastInsertId, mockProduct.Quantity).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
-	repo := &pgRepo{db}
-	id, err := repo.AddProduct(mockProduct)
+	repo := &pgRepo{db: db}
+	id, err := repo.AddProduct(context.Background(), mockProduct)
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
 	assert.Equal(t, lastInsertId, id)
@@ -86,19 +161,112 @@ func TestAddProductRollback(t *testing.T) {
 	mock.ExpectBegin()
 	var lastInsertId = 11
 	rows := sqlmock.NewRows([]string{"prod_id"}).AddRow(lastInsertId)
-	mock.ExpectQuery("INSERT INTO products (.+)").WithArgs(mockProduct.Title, mockProduct.Price).
+	mock.ExpectQuery("INSERT INTO products (.+)").
+		WithArgs(mockProduct.Category, mockProduct.Title, mockProduct.Price).
 		WillReturnRows(rows)
 
 	mock.ExpectExec("INSERT INTO inventory (.+)").WithArgs(lastInsertId, mockProduct.Quantity).
 		WillReturnError(fmt.Errorf("rollback"))
 	mock.ExpectRollback()
 
-	repo := &pgRepo{db}
-	_, err := repo.AddProduct(mockProduct)
+	repo := &pgRepo{db: db}
+	_, err := repo.AddProduct(context.Background(), mockProduct)
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
 }
 
+func TestUpdateProduct(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	updated := &models.Product{Id: mockProduct.Id, Title: "Tenet", Price: 9000,
+		Quantity: mockProduct.Quantity, Category: mockProduct.Category}
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"}).
+		AddRow(updated.Id, updated.Title, updated.Price.String(), updated.Quantity,
+			updated.Category)
+	mock.ExpectQuery(`UPDATE products SET title=\$1, price=\$2 WHERE prod_id = \$3`).
+		WithArgs(updated.Title, updated.Price, updated.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	pr, err := repo.UpdateProduct(context.Background(), updated, []string{"Title", "Price"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	if !assert.ObjectsAreEqual(updated, pr) {
+		t.Error(NotEqualErr(updated, pr))
+	}
+}
+
+func TestUpdateProductNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectQuery("UPDATE (.+)").WithArgs(mockProduct.Title, mockProduct.Id).
+		WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	pr, err := repo.UpdateProduct(context.Background(), mockProduct, []string{"Title"})
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+	assert.Nil(t, pr)
+}
+
+func TestAdjustInventory(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	delta := -10
+	adjusted := *mockProduct
+	adjusted.Quantity += delta
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"}).
+		AddRow(adjusted.Id, adjusted.Title, adjusted.Price.String(), adjusted.Quantity,
+			adjusted.Category)
+	mock.ExpectQuery("UPDATE inventory (.+)").WithArgs(delta, mockProduct.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	pr, err := repo.AdjustInventory(context.Background(), mockProduct.Id, delta)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	if !assert.ObjectsAreEqual(&adjusted, pr) {
+		t.Error(NotEqualErr(&adjusted, pr))
+	}
+}
+
+func TestAdjustInventoryOutOfInventory(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	delta := -mockProduct.Quantity - 1
+	mock.ExpectQuery("UPDATE inventory (.+)").WithArgs(delta, mockProduct.Id).
+		WillReturnError(sql.ErrNoRows)
+	rows := mock.NewRows([]string{"prod_id", "title", "price", "quan_in_stock", "category"}).
+		AddRow(mockProduct.Id, mockProduct.Title, mockProduct.Price.String(), mockProduct.Quantity,
+			mockProduct.Category)
+	mock.ExpectQuery("SELECT (.+)").WithArgs(mockProduct.Id).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	pr, err := repo.AdjustInventory(context.Background(), mockProduct.Id, delta)
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+	assert.Equal(t, models.ErrOutOfInventory("product", mockProduct.Id), err)
+	assert.Nil(t, pr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdjustInventoryNotFound(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	var id = 11
+	mock.ExpectQuery("UPDATE inventory (.+)").WithArgs(5, id).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT (.+)").WithArgs(id).WillReturnError(sql.ErrNoRows)
+
+	repo := &pgRepo{db: db}
+	pr, err := repo.AdjustInventory(context.Background(), id, 5)
+	assert.Equal(t, models.ErrNotFound("product", id), err)
+	assert.Nil(t, pr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDeleteProduct(t *testing.T) {
 	db, mock := NewMock()
 	defer db.Close()
@@ -111,8 +279,8 @@ func TestDeleteProduct(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
 
-	repo := &pgRepo{db}
-	err := repo.DeleteProduct(mockProduct.Id)
+	repo := &pgRepo{db: db}
+	err := repo.DeleteProduct(context.Background(), mockProduct.Id)
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
 }
@@ -129,8 +297,8 @@ func TestDeleteProductRollback(t *testing.T) {
 		WillReturnError(fmt.Errorf("rollback"))
 	mock.ExpectRollback()
 
-	repo := &pgRepo{db}
-	err := repo.DeleteProduct(mockProduct.Id)
+	repo := &pgRepo{db: db}
+	err := repo.DeleteProduct(context.Background(), mockProduct.Id)
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
 }
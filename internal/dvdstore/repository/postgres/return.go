@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetOrderReturns returns returns of the order sorted by id with returned products.
+// Lines of deleted products are skipped
+func (p *pgRepo) GetOrderReturns(ctx context.Context, orderId int) ([]*models.OrderReturn, error) {
+	ctx, span := startSpan(ctx, "pgRepo.GetOrderReturns", sqlGetOrderReturns)
+	defer span.End()
+
+	rows, err := p.db.QueryContext(ctx, sqlGetOrderReturns, orderId)
+	recordError(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("GetOrderReturns sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	returns := make([]*models.OrderReturn, 0)
+	var id int
+
+	for rows.Next() {
+		ret := models.OrderReturn{}
+		pr := models.Product{}
+		if err := rows.Scan(&ret.Id, &ret.OrderId, &ret.Date, &ret.NetAmount, &ret.Tax, &ret.TotalAmount,
+			&ret.RefundStatus, &pr.Id, &pr.Title, &pr.Price, &pr.Quantity, &pr.Category); err != nil {
+			return nil, fmt.Errorf("GetOrderReturns rows.Scan: %v", err)
+		}
+		// Separate different returns and populate them with products
+		if id != ret.Id {
+			returns = append(returns, &ret)
+			id = ret.Id
+		}
+		last := returns[len(returns)-1]
+		last.Products = append(last.Products, &pr)
+	}
+	if err = rows.Err(); err != nil {
+		recordError(span, err)
+		return nil, fmt.Errorf("GetOrderReturns rows.Next: %v", err)
+	}
+
+	return returns, nil
+}
+
+// AddOrderReturn records return of the order products with pending refund and returns products
+// to inventory. Returned products must have id, price and quantity fields filled, return amounts
+// are limited by not refunded amounts of the order. Returns created return, EntityError if order
+// wasn't found and ConflictError if order is not in passed status anymore or returned quantity
+// exceeds not returned quantity of the product
+func (p *pgRepo) AddOrderReturn(ctx context.Context, ret *models.OrderReturn,
+	orderStatus models.OrderStatus) (*models.OrderReturn, error) {
+	ctx, span := startSpan(ctx, "pgRepo.AddOrderReturn", "")
+	defer span.End()
+
+	// Every step of transaction is traced in its own span
+	var step trace.Span
+	startStep := func(name, query string) {
+		_, step = startSpan(ctx, name, query)
+	}
+
+	// Helper func. Ends current step with the error
+	fail := func(errString string, err error) (*models.OrderReturn, error) {
+		endSpan(step, err)
+		recordError(span, err)
+		return nil, fmt.Errorf("AddOrderReturn "+errString+": %v", err)
+	}
+
+	// Inventory is updated in the same order as by AddOrder
+	products := ret.Products
+	sort.Sort(models.SortById(products))
+
+	startStep("BEGIN", "")
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fail("tx.Begin", err)
+	}
+	defer tx.Rollback()
+	step.End()
+
+	// Lock the order and check its status
+	startStep("SELECT orders", sqlAddOrderReturnLockOrder)
+	var status models.OrderStatus
+	var leftNet, leftTax models.Money
+	err = tx.QueryRowContext(ctx, sqlAddOrderReturnLockOrder, ret.OrderId).
+		Scan(&status, &leftNet, &leftTax)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			step.End()
+			return nil, models.ErrNotFound("order", ret.OrderId)
+		}
+		return fail("SELECT orders tx.QueryRow", err)
+	}
+	step.End()
+	if status != orderStatus {
+		return nil, models.ErrStatusChanged("order", ret.OrderId)
+	}
+
+	// Check not returned quantities of products
+	startStep("SELECT orderlines", sqlAddOrderReturnQuantities)
+	rows, err := tx.QueryContext(ctx, sqlAddOrderReturnQuantities, ret.OrderId)
+	if err != nil {
+		return fail("SELECT orderlines tx.Query", err)
+	}
+	defer rows.Close()
+
+	notReturned := make(map[int]int)
+	for rows.Next() {
+		var productId, quantity int
+		if err = rows.Scan(&productId, &quantity); err != nil {
+			return fail("SELECT orderlines rows.Scan", err)
+		}
+		notReturned[productId] = quantity
+	}
+	if err = rows.Err(); err != nil {
+		return fail("SELECT orderlines rows.Next", err)
+	}
+	step.End()
+
+	for _, pr := range products {
+		if pr.Quantity > notReturned[pr.Id] {
+			return nil, models.ErrNotReturnable("product", pr.Id)
+		}
+		notReturned[pr.Id] -= pr.Quantity
+	}
+
+	// Refunds are limited by not refunded amounts of the order, the order is locked, so
+	// concurrent returns see amounts of each other
+	startStep("SELECT order_returns", sqlAddOrderReturnRefunded)
+	var refundedNet, refundedTax models.Money
+	err = tx.QueryRowContext(ctx, sqlAddOrderReturnRefunded, ret.OrderId).
+		Scan(&refundedNet, &refundedTax)
+	if err != nil {
+		return fail("SELECT order_returns tx.QueryRow", err)
+	}
+	step.End()
+
+	// Insert in order_returns
+	created := &models.OrderReturn{
+		OrderId:      ret.OrderId,
+		Date:         time.Now().UTC(),
+		Products:     products,
+		NetAmount:    ret.NetAmount,
+		Tax:          ret.Tax,
+		RefundStatus: models.RefundPending,
+	}
+	created.LimitRefund(leftNet-refundedNet, leftTax-refundedTax, returnsAll(notReturned))
+	startStep("INSERT order_returns", sqlAddOrderReturn)
+	err = tx.QueryRowContext(ctx, sqlAddOrderReturn, created.OrderId, created.Date, created.NetAmount,
+		created.Tax, created.TotalAmount, created.RefundStatus).Scan(&created.Id)
+	if err != nil {
+		return fail("INSERT order_returns tx.QueryRow", err)
+	}
+	step.End()
+
+	// Insert in order_return_lines
+	startStep("INSERT order_return_lines", sqlAddOrderReturnLines)
+	lineStmt, err := tx.PrepareContext(ctx, sqlAddOrderReturnLines)
+	if err != nil {
+		return fail("INSERT order_return_lines tx.Prepare", err)
+	}
+	defer lineStmt.Close()
+	for _, pr := range products {
+		if _, err := lineStmt.ExecContext(ctx, created.Id, pr.Id, pr.Quantity, pr.Price); err != nil {
+			return fail("INSERT order_return_lines tx.Exec", err)
+		}
+	}
+	step.End()
+
+	// Return products to inventory
+	startStep("UPDATE inventory", sqlAddOrderReturnRestock)
+	stockStmt, err := tx.PrepareContext(ctx, sqlAddOrderReturnRestock)
+	if err != nil {
+		return fail("UPDATE inventory tx.Prepare", err)
+	}
+	defer stockStmt.Close()
+	for _, pr := range products {
+		if _, err := stockStmt.ExecContext(ctx, pr.Quantity, pr.Id); err != nil {
+			return fail("UPDATE inventory tx.Exec", err)
+		}
+	}
+	step.End()
+
+	startStep("COMMIT", "")
+	if err = tx.Commit(); err != nil {
+		return fail("tx.Commit", err)
+	}
+	step.End()
+
+	return created, nil
+}
+
+// returnsAll reports if nothing is left to return by not returned quantities of products
+func returnsAll(notReturned map[int]int) bool {
+	for _, q := range notReturned {
+		if q > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateReturnRefundStatus moves refund of the return from one status to another. Returns
+// ConflictError if refund is not in passed from status anymore
+func (p *pgRepo) UpdateReturnRefundStatus(ctx context.Context, returnId int,
+	from, to models.RefundStatus) error {
+	ctx, span := startSpan(ctx, "pgRepo.UpdateReturnRefundStatus", sqlUpdateReturnRefundStatus)
+	defer span.End()
+
+	res, err := p.db.ExecContext(ctx, sqlUpdateReturnRefundStatus, to, returnId, from)
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("UpdateReturnRefundStatus sql.Exec: %v", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		recordError(span, err)
+		return fmt.Errorf("UpdateReturnRefundStatus res.RowsAffected: %v", err)
+	}
+	if n == 0 {
+		return models.ErrStatusChanged("return", returnId)
+	}
+	return nil
+}
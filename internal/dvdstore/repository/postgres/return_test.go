@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrderReturns(t *testing.T) {
+	returns := []*models.OrderReturn{
+		{Id: 1, OrderId: 10, Date: time.Now().UTC(), NetAmount: 7999, Tax: 800, TotalAmount: 8799,
+			RefundStatus: models.RefundRefunded, Products: []*models.Product{
+				{Id: 1, Title: "Interstellar", Price: 7999, Quantity: 1, Category: 14},
+			}},
+		{Id: 2, OrderId: 10, Date: time.Now().UTC(), NetAmount: 22000, Tax: 2200, TotalAmount: 24200,
+			RefundStatus: models.RefundPending, Products: []*models.Product{
+				{Id: 2, Title: "John Wick", Price: 10000, Quantity: 1, Category: 1},
+				{Id: 3, Title: "Inception", Price: 12000, Quantity: 1, Category: 14},
+			}},
+	}
+	db, mock := NewMock()
+	defer db.Close()
+
+	rows := mock.NewRows([]string{"id", "orderid", "returndate", "netamount", "tax", "totalamount",
+		"refund_status", "prod_id", "title", "price", "quantity", "category"})
+	for _, r := range returns {
+		for _, p := range r.Products {
+			rows.AddRow(r.Id, r.OrderId, r.Date, r.NetAmount.String(), r.Tax.String(),
+				r.TotalAmount.String(), r.RefundStatus, p.Id, p.Title, p.Price.String(), p.Quantity, p.Category)
+		}
+	}
+	mock.ExpectQuery("SELECT (.+) FROM order_returns").WithArgs(10).WillReturnRows(rows)
+
+	repo := &pgRepo{db: db}
+	got, err := repo.GetOrderReturns(context.Background(), 10)
+	assert.NoError(t, err)
+	if !assert.ObjectsAreEqual(returns, got) {
+		t.Error(NotEqualErr(returns, got))
+	}
+}
+
+// expectOrderReturnChecks expects locking of order 10 in delivered status with 259.98 net
+// amount and 26.00 tax and its not returned quantities
+func expectOrderReturnChecks(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, netamount, tax FROM orders (.+) FOR UPDATE").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "netamount", "tax"}).
+			AddRow(models.OrderDelivered, "259.98", "26.00"))
+	mock.ExpectQuery("SELECT (.+) FROM orderlines").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"prod_id", "quantity"}).AddRow(1, 2).AddRow(2, 1))
+}
+
+func TestAddOrderReturn(t *testing.T) {
+	ret := &models.OrderReturn{OrderId: 10, NetAmount: 17999, Tax: 1800, TotalAmount: 19799,
+		Products: []*models.Product{{Id: 2, Price: 10000, Quantity: 1},
+			{Id: 1, Price: 7999, Quantity: 1}}}
+	db, mock := NewMock()
+	defer db.Close()
+
+	expectOrderReturnChecks(mock)
+	mock.ExpectQuery("SELECT (.+) FROM order_returns").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"netamount", "tax"}).AddRow("0", "0"))
+	mock.ExpectQuery("INSERT INTO order_returns (.+)").
+		WithArgs(10, AnyTime{}, ret.NetAmount, ret.Tax, ret.TotalAmount, models.RefundPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	stmt := mock.ExpectPrepare("INSERT INTO order_return_lines (.+)")
+	stmt.ExpectExec().WithArgs(5, 1, 1, models.Money(7999)).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WithArgs(5, 2, 1, models.Money(10000)).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt = mock.ExpectPrepare("UPDATE inventory (.+)")
+	stmt.ExpectExec().WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	got, err := repo.AddOrderReturn(context.Background(), ret, models.OrderDelivered)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, 5, got.Id)
+	assert.Equal(t, models.RefundPending, got.RefundStatus)
+	assert.Equal(t, 1, got.Products[0].Id)
+}
+
+func TestAddOrderReturnLimitsRefund(t *testing.T) {
+	ret := &models.OrderReturn{OrderId: 10, NetAmount: 17999, Tax: 1801, TotalAmount: 19800,
+		Products: []*models.Product{{Id: 1, Price: 7999, Quantity: 1},
+			{Id: 2, Price: 10000, Quantity: 1}}}
+	db, mock := NewMock()
+	defer db.Close()
+
+	// Product 1 was returned once, the return of the rest refunds exactly what is left
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, netamount, tax FROM orders (.+) FOR UPDATE").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "netamount", "tax"}).
+			AddRow(models.OrderDelivered, "259.98", "26.00"))
+	mock.ExpectQuery("SELECT (.+) FROM orderlines").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"prod_id", "quantity"}).AddRow(1, 1).AddRow(2, 1))
+	mock.ExpectQuery("SELECT (.+) FROM order_returns").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"netamount", "tax"}).AddRow("79.99", "8.00"))
+	mock.ExpectQuery("INSERT INTO order_returns (.+)").
+		WithArgs(10, AnyTime{}, models.Money(17999), models.Money(1800), models.Money(19799),
+			models.RefundPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+	stmt := mock.ExpectPrepare("INSERT INTO order_return_lines (.+)")
+	stmt.ExpectExec().WithArgs(6, 1, 1, models.Money(7999)).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WithArgs(6, 2, 1, models.Money(10000)).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt = mock.ExpectPrepare("UPDATE inventory (.+)")
+	stmt.ExpectExec().WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &pgRepo{db: db}
+	got, err := repo.AddOrderReturn(context.Background(), ret, models.OrderDelivered)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, models.Money(19799), got.TotalAmount)
+}
+
+func TestAddOrderReturnNotReturnable(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	// Product 2 was ordered once and product 3 wasn't ordered
+	for _, p := range []*models.Product{{Id: 2, Quantity: 2}, {Id: 3, Quantity: 1}} {
+		expectOrderReturnChecks(mock)
+		mock.ExpectRollback()
+
+		repo := &pgRepo{db: db}
+		got, err := repo.AddOrderReturn(context.Background(), &models.OrderReturn{OrderId: 10,
+			Products: []*models.Product{p}}, models.OrderDelivered)
+		var e *models.ConflictError
+		assert.ErrorAs(t, err, &e)
+		assert.Nil(t, got)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddOrderReturnStatusChanged(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, netamount, tax FROM orders (.+) FOR UPDATE").WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "netamount", "tax"}).
+			AddRow(models.OrderRefunded, "259.98", "26.00"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, netamount, tax FROM orders (.+) FOR UPDATE").WithArgs(11).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	repo := &pgRepo{db: db}
+	products := []*models.Product{{Id: 1, Quantity: 1}}
+	_, err := repo.AddOrderReturn(context.Background(),
+		&models.OrderReturn{OrderId: 10, Products: products}, models.OrderDelivered)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	_, err = repo.AddOrderReturn(context.Background(),
+		&models.OrderReturn{OrderId: 11, Products: products}, models.OrderDelivered)
+	var entErr *models.EntityError
+	assert.ErrorAs(t, err, &entErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReturnRefundStatus(t *testing.T) {
+	db, mock := NewMock()
+	defer db.Close()
+
+	returnId := 5
+	mock.ExpectExec("UPDATE order_returns (.+)").
+		WithArgs(models.RefundRefunded, returnId, models.RefundPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE order_returns (.+)").
+		WithArgs(models.RefundRefunded, returnId, models.RefundPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := &pgRepo{db: db}
+	ctx := context.Background()
+	err := repo.UpdateReturnRefundStatus(ctx, returnId, models.RefundPending, models.RefundRefunded)
+	assert.NoError(t, err)
+	err = repo.UpdateReturnRefundStatus(ctx, returnId, models.RefundPending, models.RefundRefunded)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,105 +1,335 @@
 package repository
 
 const (
+	// Payment columns are zero values for orders without payment
 	sqlGetOrder = `
-	SELECT t.orderid, t.orderdate, t.netamount, t.tax, t.totalamount,
-	t.prod_id, p.title, p.price, t.quantity
+	SELECT t.orderid, t.orderdate, t.netamount, t.tax, t.taxrate, t.totalamount, t.status,
+	COALESCE(t.payment_method_id, 0), COALESCE(pay.id, 0), COALESCE(pay.authorization_id, ''),
+	COALESCE(pay.amount, 0), COALESCE(pay.status, ''), t.prod_id, p.title, t.price, t.quantity, p.category
 	FROM products p INNER JOIN
-		(SELECT o.*, ol.prod_id, ol.quantity
+		(SELECT o.*, ol.prod_id, ol.quantity, ol.price
 		FROM orders o INNER JOIN orderlines ol
 		ON o.orderid = ol.orderid) t
 	ON p.prod_id = t.prod_id
-	WHERE orderid=$1
+	LEFT JOIN payments pay ON pay.orderid = t.orderid
+	WHERE t.orderid=$1
 	`
+	// Keyset condition, inner and outer ORDER BY lists are filled with fmt.Sprintf.
+	// Payment columns are zero values for orders without payment
 	sqlGetCustomerOrders = `
-	SELECT t.orderid, t.orderdate, t.netamount, t.tax, t.totalamount,
-	t.prod_id, p.title, p.price, t.quantity
-	FROM products p INNER JOIN
-		(SELECT o.*, ol.prod_id, ol.quantity
-		FROM orders o INNER JOIN orderlines ol
-		ON o.orderid = ol.orderid) t
-	ON p.prod_id = t.prod_id
-	WHERE customerid=$1
+	SELECT t.orderid, t.orderdate, t.netamount, t.tax, t.taxrate, t.totalamount, t.status,
+	COALESCE(t.payment_method_id, 0), COALESCE(pay.id, 0), COALESCE(pay.authorization_id, ''),
+	COALESCE(pay.amount, 0), COALESCE(pay.status, ''), ol.prod_id, p.title, ol.price, ol.quantity, p.category
+	FROM
+		(SELECT o.*
+		FROM orders o
+		WHERE o.customerid = $1 AND (cardinality($2::varchar[]) = 0 OR o.status = ANY($2))
+		AND %v
+		ORDER BY %v
+		LIMIT $3) t
+	INNER JOIN orderlines ol ON t.orderid = ol.orderid
+	INNER JOIN products p ON p.prod_id = ol.prod_id
+	LEFT JOIN payments pay ON pay.orderid = t.orderid
+	ORDER BY %v, ol.orderlineid
 	`
-	sqlAddOrderSelectProducts = `
-	SELECT i.prod_id, i.quan_in_stock, p.price, p.title
-	FROM inventory i INNER JOIN products p
-	ON i.prod_id = p.prod_id
-	WHERE i.prod_id = ANY($1)
+	sqlAddOrderSelectInventory = `
+	SELECT prod_id, quan_in_stock
+	FROM inventory
+	WHERE prod_id = ANY($1)
+	ORDER BY prod_id
+	FOR UPDATE
 	`
+	// Order without payment method has NULL payment_method_id
 	sqlAddOrder = `
-	INSERT INTO orders (orderdate, customerid, netamount, tax, totalamount) 
-	VALUES ($1, $2, $3, $4, $5)
+	INSERT INTO orders (orderdate, customerid, netamount, tax, taxrate, totalamount, payment_method_id)
+	VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, 0))
 	RETURNING orderid
 	`
+	// Expired key is taken over by the new order, live key is left untouched
+	sqlAddOrderIdempotencyKey = `
+	INSERT INTO order_idempotency (idempotency_key, request_hash, orderid, expires_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (idempotency_key) DO UPDATE
+	SET request_hash = EXCLUDED.request_hash, orderid = EXCLUDED.orderid,
+	expires_at = EXCLUDED.expires_at
+	WHERE order_idempotency.expires_at <= now()
+	`
+	sqlGetIdempotencyKey = `
+	SELECT idempotency_key, request_hash, orderid, expires_at
+	FROM order_idempotency
+	WHERE idempotency_key = $1 AND expires_at > now()
+	`
 	sqlAddOrderOrderlines = `
-	INSERT INTO orderlines (orderlineid, orderid, prod_id, quantity, orderdate) 
-	VALUES ($1, $2, $3, $4, $5)
+	INSERT INTO orderlines (orderlineid, orderid, prod_id, quantity, orderdate, price)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	sqlAddOrderPayment = `
+	INSERT INTO payments (orderid, authorization_id, amount, status)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id
+	`
+	sqlUpdatePaymentStatus = `
+	UPDATE payments SET status = $1, updated_at = now()
+	WHERE id = $2 AND status = $3
+	`
+	sqlGetOrderReturns = `
+	SELECT r.id, r.orderid, r.returndate, r.netamount, r.tax, r.totalamount, r.refund_status,
+	rl.prod_id, p.title, rl.price, rl.quantity, p.category
+	FROM order_returns r
+	INNER JOIN order_return_lines rl ON rl.return_id = r.id
+	INNER JOIN products p ON p.prod_id = rl.prod_id
+	WHERE r.orderid = $1
+	ORDER BY r.id, rl.prod_id
+	`
+	// Lock the order, so returns of the same order are recorded one by one
+	sqlAddOrderReturnLockOrder = `
+	SELECT status, netamount, tax
+	FROM orders
+	WHERE orderid = $1
+	FOR UPDATE
+	`
+	// Not returned quantities of the order products
+	sqlAddOrderReturnQuantities = `
+	SELECT ol.prod_id, ol.quantity - COALESCE(rl.quantity, 0)
+	FROM
+		(SELECT prod_id, SUM(quantity) AS quantity
+		FROM orderlines
+		WHERE orderid = $1
+		GROUP BY prod_id) ol
+	LEFT JOIN
+		(SELECT rl.prod_id, SUM(rl.quantity) AS quantity
+		FROM order_return_lines rl INNER JOIN order_returns r ON r.id = rl.return_id
+		WHERE r.orderid = $1
+		GROUP BY rl.prod_id) rl
+	ON rl.prod_id = ol.prod_id
+	`
+	// Amounts refunded by the order returns
+	sqlAddOrderReturnRefunded = `
+	SELECT COALESCE(SUM(netamount), 0), COALESCE(SUM(tax), 0)
+	FROM order_returns
+	WHERE orderid = $1
+	`
+	sqlAddOrderReturn = `
+	INSERT INTO order_returns (orderid, returndate, netamount, tax, totalamount, refund_status)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id
+	`
+	sqlAddOrderReturnLines = `
+	INSERT INTO order_return_lines (return_id, prod_id, quantity, price)
+	VALUES ($1, $2, $3, $4)
+	`
+	sqlAddOrderReturnRestock = `
+	UPDATE inventory SET quan_in_stock = quan_in_stock + $1
+	WHERE prod_id = $2
+	`
+	sqlUpdateReturnRefundStatus = `
+	UPDATE order_returns SET refund_status = $1
+	WHERE id = $2 AND refund_status = $3
+	`
+	sqlUpdateOrderStatus = `
+	UPDATE orders SET status = $1
+	WHERE orderid = $2 AND status = $3
+	`
+	sqlCancelOrderRestock = `
+	UPDATE inventory i SET quan_in_stock = i.quan_in_stock + ol.quantity
+	FROM (SELECT prod_id, SUM(quantity) AS quantity
+		FROM orderlines
+		WHERE orderid = $1
+		GROUP BY prod_id) ol
+	WHERE i.prod_id = ol.prod_id
 	`
 
+	// Keyset condition and ORDER BY list are filled with fmt.Sprintf
 	sqlGetAllProducts = `
-	SELECT p.prod_id, p.title, p.price, i.quan_in_stock 
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
+	FROM products p INNER JOIN inventory i
+	ON p.prod_id = i.prod_id
+	WHERE %v
+	ORDER BY %v
+	LIMIT $1
+	`
+	sqlSearchProducts = `
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
 	FROM products p INNER JOIN inventory i
 	ON p.prod_id = i.prod_id
+	WHERE ($2 = '' OR p.search @@ plainto_tsquery('simple', $2))
+	AND (cardinality($3::int[]) = 0 OR p.category = ANY($3))
+	AND ($4::numeric IS NULL OR p.price >= $4)
+	AND ($5::numeric IS NULL OR p.price <= $5)
+	AND (NOT $6 OR i.quan_in_stock > 0)
+	AND %v
+	ORDER BY %v
 	LIMIT $1
 	`
+	sqlGetProductsByIds = `
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
+	FROM products p INNER JOIN inventory i
+	ON p.prod_id = i.prod_id
+	WHERE p.prod_id = ANY($1)
+	ORDER BY p.prod_id
+	`
 	sqlGetProduct = `
-	SELECT p.prod_id, p.title, p.price, i.quan_in_stock 
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
 	FROM products p INNER JOIN inventory i
 	ON p.prod_id = i.prod_id
 	WHERE p.prod_id = $1
 	`
-	// I use only 2 columns from sample database to simplify the project logic
+	// SET clause and WHERE placeholder number are filled with fmt.Sprintf
+	sqlUpdateProduct = `
+	WITH p AS (
+		UPDATE products SET %v
+		WHERE prod_id = $%v
+		RETURNING prod_id, title, price, category
+	)
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
+	FROM p INNER JOIN inventory i
+	ON p.prod_id = i.prod_id
+	`
+	sqlAdjustInventory = `
+	WITH i AS (
+		UPDATE inventory SET quan_in_stock = quan_in_stock + $1
+		WHERE prod_id = $2 AND quan_in_stock + $1 >= 0
+		RETURNING prod_id, quan_in_stock
+	)
+	SELECT p.prod_id, p.title, p.price, i.quan_in_stock, p.category
+	FROM products p INNER JOIN i
+	ON p.prod_id = i.prod_id
+	`
+	// I use only 3 columns from sample database to simplify the project logic
 	sqlAddProduct = `
 	INSERT INTO products (category, title, actor, price, special, common_prod_id)
-	VALUES (-1, $1, '', $2, -1, -1)
+	VALUES ($1, $2, '', $3, -1, -1)
 	RETURNING prod_id
 	`
 
-	// I use only 3 columns from sample database to simplify the project logic
+	sqlGetAllCategories = `
+	SELECT category, categoryname
+	FROM categories
+	ORDER BY category
+	`
+	sqlGetCategory = `
+	SELECT category, categoryname
+	FROM categories
+	WHERE category = $1
+	`
+	sqlAddCategory = `
+	INSERT INTO categories (categoryname)
+	VALUES ($1)
+	RETURNING category
+	`
+
+	// Keyset condition and ORDER BY list are filled with fmt.Sprintf
+	sqlGetAllCustomers = `
+	SELECT customerid, firstname, lastname, age, username
+	FROM customers
+	WHERE %v
+	ORDER BY %v
+	LIMIT $1
+	`
+	sqlGetCustomer = `
+	SELECT customerid, firstname, lastname, age, username, address1, COALESCE(address2, ''), city,
+	COALESCE(state, ''), COALESCE(zip, ''), country, region, COALESCE(email, ''), COALESCE(phone, ''),
+	COALESCE(income, 0), COALESCE(gender, '')
+	FROM customers
+	WHERE customerid=$1
+	`
+	sqlGetCustomerCredentials = `
+	SELECT customerid, password, role
+	FROM customers
+	WHERE lower(username) = lower($1)
+	`
+	sqlUpdateCustomerPassword = `
+	UPDATE customers SET password = $2
+	WHERE customerid = $1
+	`
+	// SET clause and WHERE placeholder number are filled with fmt.Sprintf
+	sqlUpdateCustomer = `
+	UPDATE customers SET %v
+	WHERE customerid = $%v
+	RETURNING customerid, firstname, lastname, age, username, address1, COALESCE(address2, ''), city,
+	COALESCE(state, ''), COALESCE(zip, ''), country, region, COALESCE(email, ''), COALESCE(phone, ''),
+	COALESCE(income, 0), COALESCE(gender, '')
+	`
+
 	sqlAddCustomer = `
-	INSERT INTO customers (
-		firstname,
-		lastname,
-		address1,
-		address2,
-		city,
-		state,
-		zip,
-		country,
-		region,
-		email,
-		phone,
-		creditcardtype,
-		creditcard,
-		creditcardexpiration,
-		username,
-		password,
-		age,
-		income,
-		gender
+	INSERT INTO customers (firstname, lastname, age, username, password, address1, address2, city,
+		state, zip, country, region, email, phone, income, gender)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	RETURNING customerid
+	`
+
+	sqlGetPaymentMethod = `
+	SELECT id, customerid, token, brand, last_four, exp_month, exp_year, created_at
+	FROM payment_methods
+	WHERE id = $1
+	`
+	sqlGetCustomerPaymentMethods = `
+	SELECT id, customerid, token, brand, last_four, exp_month, exp_year, created_at
+	FROM payment_methods
+	WHERE customerid = $1
+	ORDER BY id
+	`
+	sqlAddPaymentMethod = `
+	INSERT INTO payment_methods (customerid, token, brand, last_four, exp_month, exp_year)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, created_at
+	`
+	sqlDeletePaymentMethod = `
+	DELETE FROM payment_methods
+	WHERE id = $1
+	`
+
+	sqlGetCart = `
+	SELECT c.updated_at, p.prod_id, p.title, p.price, i.quan_in_stock, p.category, ci.quantity
+	FROM carts c
+	INNER JOIN cart_items ci ON ci.customerid = c.customerid
+	INNER JOIN products p ON p.prod_id = ci.prod_id
+	INNER JOIN inventory i ON i.prod_id = p.prod_id
+	WHERE c.customerid = $1
+	ORDER BY p.prod_id
+	`
+	// Cart is created or touched and the quantity is added in one statement
+	sqlAddCartItem = `
+	WITH c AS (
+		INSERT INTO carts (customerid, updated_at) VALUES ($1, now())
+		ON CONFLICT (customerid) DO UPDATE SET updated_at = EXCLUDED.updated_at
+		RETURNING customerid
 	)
-	VALUES (
-		$1,
-		$2,
-		'',
-		'',
-		'',
-		'',
-		-1,
-		'',
-		-1,
-		'',
-		'',
-		-1,
-		'',
-		'',
-		'',
-		'',
-		$3,
-		-1,
-		''
+	INSERT INTO cart_items (customerid, prod_id, quantity)
+	SELECT customerid, $2, $3 FROM c
+	ON CONFLICT (customerid, prod_id) DO UPDATE SET quantity = cart_items.quantity + EXCLUDED.quantity
+	`
+	sqlRemoveCartItem = `
+	WITH ci AS (
+		DELETE FROM cart_items
+		WHERE customerid = $1 AND prod_id = $2
+		RETURNING customerid
 	)
-	RETURNING customerid
+	UPDATE carts SET updated_at = now()
+	WHERE customerid IN (SELECT customerid FROM ci)
+	`
+	// Lock the cart, so items added concurrently are seen by the subtraction
+	sqlSubtractCartItemsLockCart = `
+	SELECT customerid
+	FROM carts
+	WHERE customerid = $1
+	FOR UPDATE
+	`
+	// Items with no quantity left are deleted, the rest are decreased
+	sqlSubtractCartItems = `
+	WITH subtracted AS (
+		SELECT * FROM unnest($2::int[], $3::int[]) AS s (prod_id, quantity)
+	), deleted AS (
+		DELETE FROM cart_items ci
+		USING subtracted s
+		WHERE ci.customerid = $1 AND ci.prod_id = s.prod_id AND ci.quantity <= s.quantity
+	)
+	UPDATE cart_items ci SET quantity = ci.quantity - s.quantity
+	FROM subtracted s
+	WHERE ci.customerid = $1 AND ci.prod_id = s.prod_id AND ci.quantity > s.quantity
+	`
+	sqlDeleteCart = `
+	DELETE FROM carts
+	WHERE customerid = $1
 	`
 )
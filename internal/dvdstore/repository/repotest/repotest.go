@@ -0,0 +1,772 @@
+// Package repotest is a conformance test suite of dvdstore.PostgresRepo implementations.
+// Every implementation must pass it to be interchangeable in the use case
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// missingId is an id no entity has. It fits postgres integer columns
+const missingId = 2147483647
+
+// Run runs the suite against repository. Repository may already have data, the suite
+// creates its own entities with unique names and checks only them
+func Run(t *testing.T, repo dvdstore.PostgresRepo) {
+	s := &suite{repo: repo, ctx: context.Background(), uniq: fmt.Sprintf("t%x", time.Now().UnixNano())}
+
+	t.Run("Customers", s.testCustomers)
+	t.Run("Credentials", s.testCredentials)
+	t.Run("Categories", s.testCategories)
+	t.Run("Products", s.testProducts)
+	t.Run("SearchProducts", s.testSearchProducts)
+	t.Run("AdjustInventory", s.testAdjustInventory)
+	t.Run("AddOrder", s.testAddOrder)
+	t.Run("AddOrderNotAvailable", s.testAddOrderNotAvailable)
+	t.Run("AddOrderIdempotency", s.testAddOrderIdempotency)
+	t.Run("AddOrderConcurrent", s.testAddOrderConcurrent)
+	t.Run("CustomerOrders", s.testCustomerOrders)
+	t.Run("OrderStatus", s.testOrderStatus)
+	t.Run("DeleteOrder", s.testDeleteOrder)
+	t.Run("PaymentMethods", s.testPaymentMethods)
+	t.Run("OrderPaymentMethod", s.testOrderPaymentMethod)
+	t.Run("OrderPayment", s.testOrderPayment)
+	t.Run("OrderReturns", s.testOrderReturns)
+	t.Run("Cart", s.testCart)
+}
+
+// suite keeps repository under test and unique suffix of created names
+type suite struct {
+	repo dvdstore.PostgresRepo
+	ctx  context.Context
+	uniq string
+	// n numbers created names
+	n int
+}
+
+// name returns unique name with prefix
+func (s *suite) name(prefix string) string {
+	s.n++
+	return fmt.Sprintf("%v%v%v", prefix, s.uniq, s.n)
+}
+
+// addCustomer adds customer with unique username
+func (s *suite) addCustomer(t *testing.T) *models.Customer {
+	cst := &models.Customer{FirstName: "John", LastName: "Doe", Age: 40, Username: s.name("user")}
+	id, err := s.repo.AddCustomer(s.ctx, cst, "hash")
+	require.NoError(t, err)
+	cst.Id = id
+	return cst
+}
+
+// addCategory adds category with unique name
+func (s *suite) addCategory(t *testing.T) int {
+	id, err := s.repo.AddCategory(s.ctx, &models.Category{Name: s.name("Category")})
+	require.NoError(t, err)
+	return id
+}
+
+// addProduct adds product with title and quantity in stock
+func (s *suite) addProduct(t *testing.T, title string, price models.Money, quantity, category int) *models.Product {
+	prod := &models.Product{Title: title, Price: price, Quantity: quantity, Category: category}
+	id, err := s.repo.AddProduct(s.ctx, prod)
+	require.NoError(t, err)
+	prod.Id = id
+	return prod
+}
+
+// addOrder adds order of products with passed quantities
+func (s *suite) addOrder(t *testing.T, customerId int, quantities map[int]int) *models.Order {
+	order := &models.Order{NetAmount: 1000, Tax: 100, TaxRate: 0.1, TotalAmount: 1100}
+	for id, q := range quantities {
+		order.Products = append(order.Products, &models.Product{Id: id, Quantity: q})
+	}
+	created, err := s.repo.AddOrder(s.ctx, customerId, order, nil)
+	require.NoError(t, err)
+	return created
+}
+
+// addPaymentMethod adds payment method of the customer
+func (s *suite) addPaymentMethod(t *testing.T, customerId int) *models.PaymentMethod {
+	pm, err := s.repo.AddPaymentMethod(s.ctx, &models.PaymentMethod{CustomerId: customerId,
+		Token: s.name("tok"), Brand: models.BrandVisa, LastFour: "4242", ExpMonth: 12, ExpYear: 2030})
+	require.NoError(t, err)
+	return pm
+}
+
+// quantity returns quantity of product in stock
+func (s *suite) quantity(t *testing.T, productId int) int {
+	prod, err := s.repo.GetProduct(s.ctx, productId)
+	require.NoError(t, err)
+	return prod.Quantity
+}
+
+func assertEntityError(t *testing.T, err error) {
+	var e *models.EntityError
+	assert.True(t, errors.As(err, &e), "want EntityError, got %v", err)
+}
+
+func assertConflictError(t *testing.T, err error) {
+	var e *models.ConflictError
+	assert.True(t, errors.As(err, &e), "want ConflictError, got %v", err)
+}
+
+func (s *suite) testCustomers(t *testing.T) {
+	cst := &models.Customer{FirstName: "John", LastName: "Doe", Age: 40, Username: s.name("user"),
+		Address1: "1 Main St", Address2: "Apt 2", City: "Boston", State: "MA", Zip: "02134", Country: "US",
+		Region: models.RegionUS, Email: "john@example.com", Phone: "6175550100", Income: 60000, Gender: "M"}
+	id, err := s.repo.AddCustomer(s.ctx, cst, "hash")
+	require.NoError(t, err)
+	cst.Id = id
+
+	got, err := s.repo.GetCustomer(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Equal(t, cst, got)
+
+	_, err = s.repo.GetCustomer(s.ctx, missingId)
+	assertEntityError(t, err)
+
+	updated, err := s.repo.UpdateCustomer(s.ctx, &models.Customer{Id: cst.Id, FirstName: "Jane", Age: 41,
+		City: "London", State: "", Zip: "SW1A 1AA", Country: "UK", Region: models.RegionWorld, Gender: "F"},
+		[]string{"FirstName", "Age", "City", "State", "Zip", "Country", "Region", "Gender"})
+	require.NoError(t, err)
+	want := *cst
+	want.FirstName, want.Age, want.City, want.State, want.Zip = "Jane", 41, "London", "", "SW1A 1AA"
+	want.Country, want.Region, want.Gender = "UK", models.RegionWorld, "F"
+	assert.Equal(t, &want, updated)
+	got, err = s.repo.GetCustomer(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Equal(t, &want, got)
+
+	_, err = s.repo.UpdateCustomer(s.ctx, &models.Customer{Id: missingId, Age: 41}, []string{"Age"})
+	assertEntityError(t, err)
+	_, err = s.repo.UpdateCustomer(s.ctx, &models.Customer{Id: cst.Id}, []string{"Username"})
+	assert.Error(t, err)
+
+	// Customers page after the cursor starts right after the customer
+	next := s.addCustomer(t)
+	page, err := s.repo.GetAllCustomers(s.ctx, 1, models.SortId, &models.Cursor{Id: cst.Id})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, next.Id, page[0].Id)
+	_, err = s.repo.GetAllCustomers(s.ctx, 1, "age", nil)
+	assert.Error(t, err)
+
+	require.NoError(t, s.repo.DeleteCustomer(s.ctx, cst.Id))
+	_, err = s.repo.GetCustomer(s.ctx, cst.Id)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testCredentials(t *testing.T) {
+	cst := s.addCustomer(t)
+
+	// Usernames are unique regardless of case
+	_, err := s.repo.AddCustomer(s.ctx, &models.Customer{FirstName: "John", LastName: "Doe", Age: 40,
+		Username: "U" + cst.Username[1:]}, "hash")
+	assertConflictError(t, err)
+
+	id, hash, role, err := s.repo.GetCustomerCredentials(s.ctx, "U"+cst.Username[1:])
+	require.NoError(t, err)
+	assert.Equal(t, cst.Id, id)
+	assert.Equal(t, "hash", hash)
+	assert.Equal(t, models.RoleCustomer, role)
+
+	require.NoError(t, s.repo.UpdateCustomerPassword(s.ctx, cst.Id, "new hash"))
+	_, hash, _, err = s.repo.GetCustomerCredentials(s.ctx, cst.Username)
+	require.NoError(t, err)
+	assert.Equal(t, "new hash", hash)
+
+	_, _, _, err = s.repo.GetCustomerCredentials(s.ctx, s.name("nobody"))
+	assertEntityError(t, err)
+}
+
+func (s *suite) testCategories(t *testing.T) {
+	name := s.name("Category")
+	id, err := s.repo.AddCategory(s.ctx, &models.Category{Name: name})
+	require.NoError(t, err)
+
+	got, err := s.repo.GetCategory(s.ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Category{Id: id, Name: name}, got)
+
+	_, err = s.repo.GetCategory(s.ctx, missingId)
+	assertEntityError(t, err)
+
+	// Category names are unique regardless of case
+	_, err = s.repo.AddCategory(s.ctx, &models.Category{Name: "c" + name[1:]})
+	assertConflictError(t, err)
+
+	all, err := s.repo.GetAllCategories(s.ctx)
+	require.NoError(t, err)
+	assert.Contains(t, all, got)
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].Id, all[i].Id)
+	}
+}
+
+func (s *suite) testProducts(t *testing.T) {
+	category := s.addCategory(t)
+	prod := s.addProduct(t, s.name("Movie"), 1999, 10, category)
+
+	got, err := s.repo.GetProduct(s.ctx, prod.Id)
+	require.NoError(t, err)
+	assert.Equal(t, prod, got)
+
+	_, err = s.repo.GetProduct(s.ctx, missingId)
+	assertEntityError(t, err)
+
+	updated, err := s.repo.UpdateProduct(s.ctx, &models.Product{Id: prod.Id, Title: "Updated", Price: 2999},
+		[]string{"Title", "Price"})
+	require.NoError(t, err)
+	assert.Equal(t, &models.Product{Id: prod.Id, Title: "Updated", Price: 2999, Quantity: 10,
+		Category: category}, updated)
+
+	_, err = s.repo.UpdateProduct(s.ctx, &models.Product{Id: missingId, Price: 2999}, []string{"Price"})
+	assertEntityError(t, err)
+	_, err = s.repo.UpdateProduct(s.ctx, &models.Product{Id: prod.Id}, []string{"Quantity"})
+	assert.Error(t, err)
+
+	// Missing products are skipped, found are sorted by id
+	other := s.addProduct(t, s.name("Movie"), 999, 1, category)
+	byIds, err := s.repo.GetProductsByIds(s.ctx, []int{other.Id, missingId, prod.Id})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.Product{updated, other}, byIds)
+
+	require.NoError(t, s.repo.DeleteProduct(s.ctx, prod.Id))
+	_, err = s.repo.GetProduct(s.ctx, prod.Id)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testSearchProducts(t *testing.T) {
+	category := s.addCategory(t)
+	word := s.name("word")
+	a := s.addProduct(t, "Alpha "+word, 3000, 5, category)
+	b := s.addProduct(t, "Beta "+word, 1000, 0, category)
+	c := s.addProduct(t, "Gamma "+word, 2000, 7, category)
+	s.addProduct(t, "Delta "+s.name("word"), 2000, 7, category)
+
+	search := func(filter *models.ProductFilter, limit int, sortBy string,
+		after *models.Cursor) []*models.Product {
+		products, err := s.repo.SearchProducts(s.ctx, filter, limit, sortBy, after)
+		require.NoError(t, err)
+		return products
+	}
+
+	assert.Equal(t, []*models.Product{a, b, c}, search(&models.ProductFilter{Query: word}, 10, models.SortId, nil))
+	assert.Equal(t, []*models.Product{b, c, a}, search(&models.ProductFilter{Query: word}, 10, models.SortPrice, nil))
+	assert.Equal(t, []*models.Product{a, c},
+		search(&models.ProductFilter{Query: word, InStockOnly: true}, 10, models.SortTitle, nil))
+
+	minPrice, maxPrice := models.Money(1500), models.Money(2000)
+	assert.Equal(t, []*models.Product{c}, search(&models.ProductFilter{Query: word, Categories: []int{category},
+		MinPrice: &minPrice, MaxPrice: &maxPrice}, 10, models.SortId, nil))
+	assert.Empty(t, search(&models.ProductFilter{Query: word, Categories: []int{missingId}}, 10, models.SortId, nil))
+
+	// Pages by title continue after the cursor
+	first := search(&models.ProductFilter{Query: word}, 1, models.SortTitle, nil)
+	assert.Equal(t, []*models.Product{a}, first)
+	next := search(&models.ProductFilter{Query: word}, 2, models.SortTitle,
+		&models.Cursor{SortBy: models.SortTitle, Value: a.SortValue(models.SortTitle), Id: a.Id})
+	assert.Equal(t, []*models.Product{b, c}, next)
+
+	// Titles are sorted by bytes, so upper case letters go before lower case ones
+	mixed := s.name("mixed")
+	lowerA := s.addProduct(t, "alpha "+mixed, 1000, 1, category)
+	lowerB := s.addProduct(t, "beta "+mixed, 1000, 1, category)
+	upperG := s.addProduct(t, "Gamma "+mixed, 1000, 1, category)
+	assert.Equal(t, []*models.Product{upperG, lowerA, lowerB},
+		search(&models.ProductFilter{Query: mixed}, 10, models.SortTitle, nil))
+	assert.Equal(t, []*models.Product{lowerA, lowerB}, search(&models.ProductFilter{Query: mixed}, 10,
+		models.SortTitle, &models.Cursor{SortBy: models.SortTitle, Value: upperG.SortValue(models.SortTitle),
+			Id: upperG.Id}))
+
+	// Pages by price continue after the cursor
+	next = search(&models.ProductFilter{Query: word}, 10, models.SortPrice,
+		&models.Cursor{SortBy: models.SortPrice, Value: b.SortValue(models.SortPrice), Id: b.Id})
+	assert.Equal(t, []*models.Product{c, a}, next)
+
+	_, err := s.repo.SearchProducts(s.ctx, &models.ProductFilter{}, 10, "actor", nil)
+	assert.Error(t, err)
+}
+
+func (s *suite) testAdjustInventory(t *testing.T) {
+	prod := s.addProduct(t, s.name("Movie"), 1999, 5, s.addCategory(t))
+
+	adjusted, err := s.repo.AdjustInventory(s.ctx, prod.Id, -5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, adjusted.Quantity)
+
+	_, err = s.repo.AdjustInventory(s.ctx, prod.Id, -1)
+	assertEntityError(t, err)
+	assert.Equal(t, 0, s.quantity(t, prod.Id))
+
+	adjusted, err = s.repo.AdjustInventory(s.ctx, prod.Id, 3)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Product{Id: prod.Id, Title: prod.Title, Price: prod.Price, Quantity: 3,
+		Category: prod.Category}, adjusted)
+
+	_, err = s.repo.AdjustInventory(s.ctx, missingId, 1)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testAddOrder(t *testing.T) {
+	cst := s.addCustomer(t)
+	category := s.addCategory(t)
+	a := s.addProduct(t, s.name("Movie"), 1000, 5, category)
+	b := s.addProduct(t, s.name("Movie"), 2000, 5, category)
+
+	before := time.Now().Add(-time.Minute)
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{NetAmount: 4000, Tax: 400, TaxRate: 0.1,
+		TotalAmount: 4400, Products: []*models.Product{{Id: b.Id, Price: b.Price, Quantity: 1},
+			{Id: a.Id, Price: a.Price, Quantity: 2}}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrderPending, order.Status)
+	assert.True(t, order.Date.After(before))
+	assert.Equal(t, 3, s.quantity(t, a.Id))
+	assert.Equal(t, 4, s.quantity(t, b.Id))
+
+	// Ordered products have current product fields, order price and ordered quantity
+	_, err = s.repo.UpdateProduct(s.ctx, &models.Product{Id: a.Id, Price: 1500}, []string{"Price"})
+	require.NoError(t, err)
+	got, err := s.repo.GetOrder(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, order.Id, got.Id)
+	assert.Equal(t, models.Money(4000), got.NetAmount)
+	assert.Equal(t, models.Money(400), got.Tax)
+	assert.Equal(t, 0.1, got.TaxRate)
+	assert.Equal(t, models.Money(4400), got.TotalAmount)
+	assert.Equal(t, models.OrderPending, got.Status)
+	assert.ElementsMatch(t, []*models.Product{
+		{Id: a.Id, Title: a.Title, Price: a.Price, Quantity: 2, Category: category},
+		{Id: b.Id, Title: b.Title, Price: b.Price, Quantity: 1, Category: category},
+	}, got.Products)
+
+	_, err = s.repo.GetOrder(s.ctx, missingId)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testAddOrderNotAvailable(t *testing.T) {
+	cst := s.addCustomer(t)
+	category := s.addCategory(t)
+	a := s.addProduct(t, s.name("Movie"), 1000, 5, category)
+	b := s.addProduct(t, s.name("Movie"), 2000, 1, category)
+
+	// Order is not created partially: available product stays in stock
+	_, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: a.Id, Quantity: 2}, {Id: b.Id, Quantity: 2}}}, nil)
+	assertEntityError(t, err)
+	assert.Equal(t, 5, s.quantity(t, a.Id))
+	assert.Equal(t, 1, s.quantity(t, b.Id))
+
+	_, err = s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: a.Id, Quantity: 2}, {Id: missingId, Quantity: 1}}}, nil)
+	assertEntityError(t, err)
+	assert.Equal(t, 5, s.quantity(t, a.Id))
+
+	_, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortId, nil)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testAddOrderIdempotency(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 5, s.addCategory(t))
+
+	key := &models.IdempotencyKey{Key: s.name("key"), RequestHash: "hash", ExpiresAt: time.Now().Add(time.Hour)}
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, key)
+	require.NoError(t, err)
+
+	got, err := s.repo.GetIdempotencyKey(s.ctx, key.Key)
+	require.NoError(t, err)
+	assert.Equal(t, key.Key, got.Key)
+	assert.Equal(t, "hash", got.RequestHash)
+	assert.Equal(t, order.Id, got.OrderId)
+
+	// Live key is taken, the second order is not created
+	_, err = s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, key)
+	assertConflictError(t, err)
+	assert.Equal(t, 4, s.quantity(t, prod.Id))
+
+	// Expired key is not returned and is taken over by the new order
+	expired := &models.IdempotencyKey{Key: s.name("key"), RequestHash: "hash", ExpiresAt: time.Now().Add(-time.Hour)}
+	_, err = s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, expired)
+	require.NoError(t, err)
+	_, err = s.repo.GetIdempotencyKey(s.ctx, expired.Key)
+	assertEntityError(t, err)
+
+	expired.ExpiresAt = time.Now().Add(time.Hour)
+	order, err = s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, expired)
+	require.NoError(t, err)
+	got, err = s.repo.GetIdempotencyKey(s.ctx, expired.Key)
+	require.NoError(t, err)
+	assert.Equal(t, order.Id, got.OrderId)
+}
+
+func (s *suite) testAddOrderConcurrent(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 5, s.addCategory(t))
+
+	// Stock is never oversold by concurrent orders
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	placed := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+				Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, nil)
+			if err == nil {
+				mu.Lock()
+				placed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5, placed)
+	assert.Equal(t, 0, s.quantity(t, prod.Id))
+}
+
+func (s *suite) testCustomerOrders(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 10, s.addCategory(t))
+
+	first := s.addOrder(t, cst.Id, map[int]int{prod.Id: 1})
+	second := s.addOrder(t, cst.Id, map[int]int{prod.Id: 2})
+	third := s.addOrder(t, cst.Id, map[int]int{prod.Id: 3})
+	require.NoError(t, s.repo.UpdateOrderStatus(s.ctx, second.Id, models.OrderPending, models.OrderPaid))
+
+	ids := func(orders []*models.Order) []int {
+		ids := make([]int, 0, len(orders))
+		for _, o := range orders {
+			ids = append(ids, o.Id)
+		}
+		return ids
+	}
+
+	orders, err := s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortId, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{first.Id, second.Id, third.Id}, ids(orders))
+	require.Len(t, orders[2].Products, 1)
+	assert.Equal(t, 3, orders[2].Products[0].Quantity)
+
+	orders, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, []models.OrderStatus{models.OrderPending}, 10,
+		models.SortId, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{first.Id, third.Id}, ids(orders))
+
+	orders, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 1, models.SortDate,
+		&models.Cursor{SortBy: models.SortDate, Value: first.SortValue(models.SortDate), Id: first.Id})
+	require.NoError(t, err)
+	assert.Equal(t, []int{second.Id}, ids(orders))
+
+	// The last page is empty, the first page without orders is not found
+	orders, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortId, &models.Cursor{Id: third.Id})
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+	_, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, []models.OrderStatus{models.OrderShipped}, 10,
+		models.SortId, nil)
+	assertEntityError(t, err)
+
+	_, err = s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortPrice, nil)
+	assert.Error(t, err)
+}
+
+func (s *suite) testOrderStatus(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 10, s.addCategory(t))
+	order := s.addOrder(t, cst.Id, map[int]int{prod.Id: 4})
+
+	require.NoError(t, s.repo.UpdateOrderStatus(s.ctx, order.Id, models.OrderPending, models.OrderPaid))
+	err := s.repo.UpdateOrderStatus(s.ctx, order.Id, models.OrderPending, models.OrderPaid)
+	assertConflictError(t, err)
+
+	// Cancelled order returns products to inventory once
+	err = s.repo.CancelOrder(s.ctx, order.Id, models.OrderPending)
+	assertConflictError(t, err)
+	assert.Equal(t, 6, s.quantity(t, prod.Id))
+	require.NoError(t, s.repo.CancelOrder(s.ctx, order.Id, models.OrderPaid))
+	assert.Equal(t, 10, s.quantity(t, prod.Id))
+	err = s.repo.CancelOrder(s.ctx, order.Id, models.OrderPaid)
+	assertConflictError(t, err)
+	assert.Equal(t, 10, s.quantity(t, prod.Id))
+
+	got, err := s.repo.GetOrder(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrderCancelled, got.Status)
+
+	err = s.repo.UpdateOrderStatus(s.ctx, missingId, models.OrderPending, models.OrderPaid)
+	assertConflictError(t, err)
+}
+
+func (s *suite) testDeleteOrder(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 10, s.addCategory(t))
+	key := &models.IdempotencyKey{Key: s.name("key"), RequestHash: "hash", ExpiresAt: time.Now().Add(time.Hour)}
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{
+		Products: []*models.Product{{Id: prod.Id, Quantity: 4}}}, key)
+	require.NoError(t, err)
+
+	// Deleted order doesn't return products to inventory and frees its key
+	require.NoError(t, s.repo.DeleteOrder(s.ctx, order.Id))
+	_, err = s.repo.GetOrder(s.ctx, order.Id)
+	assertEntityError(t, err)
+	assert.Equal(t, 6, s.quantity(t, prod.Id))
+	_, err = s.repo.GetIdempotencyKey(s.ctx, key.Key)
+	assertEntityError(t, err)
+}
+
+func (s *suite) testPaymentMethods(t *testing.T) {
+	cst := s.addCustomer(t)
+
+	before := time.Now().Add(-time.Minute)
+	method := &models.PaymentMethod{CustomerId: cst.Id, Token: s.name("tok"), Brand: models.BrandAmex,
+		LastFour: "0005", ExpMonth: 3, ExpYear: 2031}
+	pm, err := s.repo.AddPaymentMethod(s.ctx, method)
+	require.NoError(t, err)
+	assert.NotZero(t, pm.Id)
+	assert.True(t, pm.CreatedAt.After(before))
+
+	got, err := s.repo.GetPaymentMethod(s.ctx, pm.Id)
+	require.NoError(t, err)
+	want := *method
+	want.Id, want.CreatedAt = pm.Id, got.CreatedAt
+	assert.Equal(t, &want, got)
+	assert.WithinDuration(t, pm.CreatedAt, got.CreatedAt, time.Millisecond)
+
+	_, err = s.repo.GetPaymentMethod(s.ctx, missingId)
+	assertEntityError(t, err)
+	_, err = s.repo.AddPaymentMethod(s.ctx, &models.PaymentMethod{CustomerId: missingId, Token: s.name("tok"),
+		Brand: models.BrandVisa, LastFour: "4242", ExpMonth: 12, ExpYear: 2030})
+	assertEntityError(t, err)
+
+	// Customer may have several payment methods, they are listed by id
+	second := s.addPaymentMethod(t, cst.Id)
+	s.addPaymentMethod(t, s.addCustomer(t).Id)
+	methods, err := s.repo.GetCustomerPaymentMethods(s.ctx, cst.Id)
+	require.NoError(t, err)
+	require.Len(t, methods, 2)
+	assert.Equal(t, pm.Id, methods[0].Id)
+	assert.Equal(t, second.Id, methods[1].Id)
+
+	require.NoError(t, s.repo.DeletePaymentMethod(s.ctx, pm.Id))
+	_, err = s.repo.GetPaymentMethod(s.ctx, pm.Id)
+	assertEntityError(t, err)
+
+	// Payment methods are deleted with the customer
+	require.NoError(t, s.repo.DeleteCustomer(s.ctx, cst.Id))
+	methods, err = s.repo.GetCustomerPaymentMethods(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Empty(t, methods)
+}
+
+func (s *suite) testOrderPaymentMethod(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 10, s.addCategory(t))
+	pm := s.addPaymentMethod(t, cst.Id)
+
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{PaymentMethodId: pm.Id,
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, pm.Id, order.PaymentMethodId)
+	got, err := s.repo.GetOrder(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, pm.Id, got.PaymentMethodId)
+
+	// Order isn't created with missing payment method
+	_, err = s.repo.AddOrder(s.ctx, cst.Id, &models.Order{PaymentMethodId: missingId,
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}}}, nil)
+	assertEntityError(t, err)
+	assert.Equal(t, 9, s.quantity(t, prod.Id))
+
+	// Order is kept without deleted payment method
+	require.NoError(t, s.repo.DeletePaymentMethod(s.ctx, pm.Id))
+	orders, err := s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortId, nil)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Zero(t, orders[0].PaymentMethodId)
+}
+
+func (s *suite) testOrderPayment(t *testing.T) {
+	cst := s.addCustomer(t)
+	prod := s.addProduct(t, s.name("Movie"), 1000, 10, s.addCategory(t))
+	pm := s.addPaymentMethod(t, cst.Id)
+
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{TotalAmount: 1100, PaymentMethodId: pm.Id,
+		Products: []*models.Product{{Id: prod.Id, Quantity: 1}},
+		Payment:  &models.Payment{AuthorizationId: "auth_1", Amount: 1100, Status: models.PaymentAuthorized}}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, order.Payment)
+	assert.NotZero(t, order.Payment.Id)
+	assert.Equal(t, order.Id, order.Payment.OrderId)
+
+	// Payment status is moved only from the current status
+	require.NoError(t, s.repo.UpdatePaymentStatus(s.ctx, order.Payment.Id, models.PaymentAuthorized,
+		models.PaymentCaptured))
+	err = s.repo.UpdatePaymentStatus(s.ctx, order.Payment.Id, models.PaymentAuthorized, models.PaymentVoided)
+	assertConflictError(t, err)
+
+	got, err := s.repo.GetOrder(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Payment{Id: order.Payment.Id, OrderId: order.Id, AuthorizationId: "auth_1",
+		Amount: 1100, Status: models.PaymentCaptured}, got.Payment)
+
+	// Order without payment has none
+	unpaid := s.addOrder(t, cst.Id, map[int]int{prod.Id: 1})
+	assert.Nil(t, unpaid.Payment)
+	orders, err := s.repo.GetCustomerOrders(s.ctx, cst.Id, nil, 10, models.SortId, nil)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, got.Payment, orders[0].Payment)
+	assert.Nil(t, orders[1].Payment)
+}
+
+func (s *suite) testOrderReturns(t *testing.T) {
+	cst := s.addCustomer(t)
+	category := s.addCategory(t)
+	a := s.addProduct(t, s.name("Movie"), 1000, 10, category)
+	b := s.addProduct(t, s.name("Movie"), 2000, 10, category)
+	order, err := s.repo.AddOrder(s.ctx, cst.Id, &models.Order{NetAmount: 5000, Tax: 500, TaxRate: 0.1,
+		TotalAmount: 5500, Products: []*models.Product{
+			{Id: a.Id, Quantity: 3, Price: a.Price}, {Id: b.Id, Quantity: 1, Price: b.Price}}}, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.repo.UpdateOrderStatus(s.ctx, order.Id, models.OrderPending, models.OrderDelivered))
+
+	returns, err := s.repo.GetOrderReturns(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Empty(t, returns)
+
+	// Partial return restocks returned products only
+	ret, err := s.repo.AddOrderReturn(s.ctx, &models.OrderReturn{OrderId: order.Id, NetAmount: 2000, Tax: 200,
+		TotalAmount: 2200, Products: []*models.Product{{Id: a.Id, Quantity: 2, Price: a.Price}}},
+		models.OrderDelivered)
+	require.NoError(t, err)
+	assert.NotZero(t, ret.Id)
+	assert.Equal(t, models.RefundPending, ret.RefundStatus)
+	assert.Equal(t, 9, s.quantity(t, a.Id))
+	assert.Equal(t, 9, s.quantity(t, b.Id))
+
+	// Returned quantity can't exceed not returned quantity
+	_, err = s.repo.AddOrderReturn(s.ctx, &models.OrderReturn{OrderId: order.Id,
+		Products: []*models.Product{{Id: a.Id, Quantity: 2, Price: a.Price}}}, models.OrderDelivered)
+	assertConflictError(t, err)
+	_, err = s.repo.AddOrderReturn(s.ctx, &models.OrderReturn{OrderId: order.Id,
+		Products: []*models.Product{{Id: a.Id, Quantity: 1, Price: a.Price}}}, models.OrderShipped)
+	assertConflictError(t, err)
+	_, err = s.repo.AddOrderReturn(s.ctx, &models.OrderReturn{OrderId: missingId,
+		Products: []*models.Product{{Id: a.Id, Quantity: 1, Price: a.Price}}}, models.OrderDelivered)
+	assertEntityError(t, err)
+	assert.Equal(t, 9, s.quantity(t, a.Id))
+
+	// Order keeps its amounts and lists the return
+	got, err := s.repo.GetOrder(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.Money(5500), got.TotalAmount)
+	returns, err = s.repo.GetOrderReturns(s.ctx, order.Id)
+	require.NoError(t, err)
+	require.Len(t, returns, 1)
+	assert.Equal(t, ret.Id, returns[0].Id)
+	assert.Equal(t, order.Id, returns[0].OrderId)
+	assert.Equal(t, models.Money(2200), returns[0].TotalAmount)
+	assert.Equal(t, []*models.Product{{Id: a.Id, Title: a.Title, Price: a.Price, Quantity: 2,
+		Category: category}}, returns[0].Products)
+
+	// Refund moves from pending to refunded once
+	require.NoError(t, s.repo.UpdateReturnRefundStatus(s.ctx, ret.Id, models.RefundPending,
+		models.RefundRefunded))
+	err = s.repo.UpdateReturnRefundStatus(s.ctx, ret.Id, models.RefundPending, models.RefundRefunded)
+	assertConflictError(t, err)
+	returns, err = s.repo.GetOrderReturns(s.ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.RefundRefunded, returns[0].RefundStatus)
+
+	// The return of the rest refunds exactly what is left
+	ret, err = s.repo.AddOrderReturn(s.ctx, &models.OrderReturn{OrderId: order.Id, NetAmount: 3000, Tax: 400,
+		TotalAmount: 3400, Products: []*models.Product{{Id: a.Id, Quantity: 1, Price: a.Price},
+			{Id: b.Id, Quantity: 1, Price: b.Price}}}, models.OrderDelivered)
+	require.NoError(t, err)
+	assert.Equal(t, models.Money(3000), ret.NetAmount)
+	assert.Equal(t, models.Money(300), ret.Tax)
+	assert.Equal(t, models.Money(3300), ret.TotalAmount)
+	returns, err = s.repo.GetOrderReturns(s.ctx, order.Id)
+	require.NoError(t, err)
+	require.Len(t, returns, 2)
+	assert.Equal(t, models.Money(3300), returns[1].TotalAmount)
+}
+
+func (s *suite) testCart(t *testing.T) {
+	cst := s.addCustomer(t)
+	category := s.addCategory(t)
+	a := s.addProduct(t, s.name("Movie"), 1000, 10, category)
+	b := s.addProduct(t, s.name("Movie"), 2000, 1, category)
+	c := s.addProduct(t, s.name("Movie"), 3000, 1, category)
+
+	// Customer without cart has empty one
+	cart, err := s.repo.GetCart(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Cart{CustomerId: cst.Id, Items: []*models.CartItem{}}, cart)
+
+	// Quantities of the same product are added up, items have current products
+	require.NoError(t, s.repo.AddCartItem(s.ctx, cst.Id, b.Id, 2))
+	require.NoError(t, s.repo.AddCartItem(s.ctx, cst.Id, a.Id, 1))
+	require.NoError(t, s.repo.AddCartItem(s.ctx, cst.Id, a.Id, 2))
+	_, err = s.repo.UpdateProduct(s.ctx, &models.Product{Id: a.Id, Price: 1500}, []string{"Price"})
+	require.NoError(t, err)
+	cart, err = s.repo.GetCart(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.False(t, cart.UpdatedAt.IsZero())
+	assert.Equal(t, []*models.CartItem{
+		{Product: &models.Product{Id: a.Id, Title: a.Title, Price: 1500, Quantity: 10, Category: category},
+			Quantity: 3},
+		{Product: &models.Product{Id: b.Id, Title: b.Title, Price: 2000, Quantity: 1, Category: category},
+			Quantity: 2},
+	}, cart.Items)
+
+	err = s.repo.AddCartItem(s.ctx, cst.Id, missingId, 1)
+	assertEntityError(t, err)
+	err = s.repo.AddCartItem(s.ctx, missingId, a.Id, 1)
+	assertEntityError(t, err)
+
+	// Removed and deleted products are not in the cart
+	require.NoError(t, s.repo.RemoveCartItem(s.ctx, cst.Id, a.Id))
+	err = s.repo.RemoveCartItem(s.ctx, cst.Id, a.Id)
+	assertEntityError(t, err)
+	require.NoError(t, s.repo.DeleteProduct(s.ctx, b.Id))
+	cart, err = s.repo.GetCart(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+
+	// Subtracted quantities are taken from the items, items with no quantity left are removed
+	require.NoError(t, s.repo.AddCartItem(s.ctx, cst.Id, a.Id, 3))
+	require.NoError(t, s.repo.AddCartItem(s.ctx, cst.Id, c.Id, 1))
+	require.NoError(t, s.repo.SubtractCartItems(s.ctx, cst.Id, []*models.Product{
+		{Id: a.Id, Quantity: 2}, {Id: c.Id, Quantity: 1}, {Id: missingId, Quantity: 1}}))
+	cart, err = s.repo.GetCart(s.ctx, cst.Id)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 1)
+	assert.Equal(t, a.Id, cart.Items[0].Product.Id)
+	assert.Equal(t, 1, cart.Items[0].Quantity)
+	require.NoError(t, s.repo.SubtractCartItems(s.ctx, missingId, []*models.Product{{Id: a.Id, Quantity: 1}}))
+
+	require.NoError(t, s.repo.DeleteCart(s.ctx, cst.Id))
+	cart, err = s.repo.GetCart(s.ctx, cst.Id)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+	err = s.repo.RemoveCartItem(s.ctx, cst.Id, a.Id)
+	assertEntityError(t, err)
+}
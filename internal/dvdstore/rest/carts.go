@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/alexzh7/sample-service/proto"
+)
+
+// getCart handles GET /v1/customers/{id}/cart
+func (h *dvdstoreHandler) getCart(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	cart, err := h.uc.GetCart(r.Context(), customerId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.GetCartRes{Cart: cart.ToProto()})
+}
+
+// addToCart handles POST /v1/customers/{id}/cart/items with {"ProductID": 1, "Quantity": 2} body
+func (h *dvdstoreHandler) addToCart(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.AddToCartReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	cart, err := h.uc.AddToCart(r.Context(), customerId, int(req.GetProductID()), int(req.GetQuantity()))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.AddToCartRes{Cart: cart.ToProto()})
+}
+
+// removeFromCart handles DELETE /v1/customers/{id}/cart/items/{productId}
+func (h *dvdstoreHandler) removeFromCart(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	productId, err := pathInt(r, "productId", "productId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	cart, err := h.uc.RemoveFromCart(r.Context(), customerId, productId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.RemoveFromCartRes{Cart: cart.ToProto()})
+}
+
+// checkout handles POST /v1/customers/{id}/cart/checkout with
+// {"PaymentMethodID": 3, "IdempotencyKey": "..."} body
+func (h *dvdstoreHandler) checkout(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.CheckoutReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	order, err := h.uc.Checkout(r.Context(), customerId, int(req.GetPaymentMethodID()), req.GetIdempotencyKey())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.CheckoutRes{Order: order.ToProto()})
+}
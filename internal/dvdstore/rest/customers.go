@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// getCustomers handles GET /v1/customers?limit=&pageToken=&sortBy=
+func (h *dvdstoreHandler) getCustomers(w http.ResponseWriter, r *http.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	customers, next, err := h.uc.GetCustomers(r.Context(), page)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	res := &proto.GetCustomersRes{CustomerList: make([]*proto.Customer, 0), NextPageToken: next}
+	for _, c := range customers {
+		res.CustomerList = append(res.CustomerList, c.ToProto())
+	}
+	h.write(w, http.StatusOK, res)
+}
+
+// getCustomer handles GET /v1/customers/{id}
+func (h *dvdstoreHandler) getCustomer(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	customer, err := h.uc.GetCustomer(r.Context(), customerId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.GetCustomerRes{Customer: customer.ToProto()})
+}
+
+// addCustomer handles POST /v1/customers with AddCustomerReq body
+func (h *dvdstoreHandler) addCustomer(w http.ResponseWriter, r *http.Request) {
+	req := &proto.AddCustomerReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	id, err := h.uc.AddCustomer(r.Context(), models.CustomerFromProto(req.GetCustomer()), req.GetPassword())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.AddCustomerRes{CustomerID: int64(id)})
+}
+
+// login handles POST /v1/login with LoginReq body
+func (h *dvdstoreHandler) login(w http.ResponseWriter, r *http.Request) {
+	req := &proto.LoginReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	credentials := &models.Credentials{Username: req.GetUsername(), Password: req.GetPassword()}
+	token, expiresAt, err := h.uc.Login(r.Context(), credentials)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.LoginRes{Token: token, ExpiresAt: timestamppb.New(expiresAt)})
+}
+
+// updateCustomer handles PATCH /v1/customers/{id}?updateMask= with Customer body.
+// Update mask is a comma separated list of fields, all updatable fields are updated if empty
+func (h *dvdstoreHandler) updateCustomer(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.Customer{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req.Id = int64(customerId)
+
+	fields := service.MaskFields(updateMask(r), req)
+	updated, err := h.uc.UpdateCustomer(r.Context(), models.CustomerFromProto(req), fields)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.UpdateCustomerRes{Customer: updated.ToProto()})
+}
+
+// deleteCustomer handles DELETE /v1/customers/{id}
+func (h *dvdstoreHandler) deleteCustomer(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if err := h.uc.DeleteCustomer(r.Context(), customerId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.DeleteCustomerRes{})
+}
+
+// updateMask returns update mask from comma separated "updateMask" query parameter
+func updateMask(r *http.Request) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{}
+	for _, path := range strings.Split(r.URL.Query().Get("updateMask"), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			mask.Paths = append(mask.Paths, path)
+		}
+	}
+	return mask
+}
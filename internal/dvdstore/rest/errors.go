@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+
+	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// writeError writes error returned by use case with http status matching its grpc code.
+// Body is a JSON of google.rpc.Status with code and message
+func (h *dvdstoreHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	st := service.ErrorStatus(r.Context(), err)
+	h.write(w, httpStatus(st.Code()), st.Proto())
+}
+
+// httpStatus maps grpc codes used by the service to http statuses
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Canceled:
+		// Client closed request, non-standard status used by nginx
+		return 499
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
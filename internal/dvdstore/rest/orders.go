@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+)
+
+// getOrder handles GET /v1/orders/{id}
+func (h *dvdstoreHandler) getOrder(w http.ResponseWriter, r *http.Request) {
+	orderId, err := pathId(r, "orderId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	order, err := h.uc.GetOrder(r.Context(), orderId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.GetOrderRes{Order: order.ToProto()})
+}
+
+// getCustomerOrders handles GET /v1/customers/{id}/orders?status=&limit=&pageToken=&sortBy=.
+// Status is an OrderStatus name and may be repeated
+func (h *dvdstoreHandler) getCustomerOrders(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	statuses := make([]models.OrderStatus, 0)
+	for _, s := range r.URL.Query()["status"] {
+		value, ok := proto.OrderStatus_value[s]
+		if !ok {
+			h.writeError(w, r, models.ErrFieldsNotValid("status"))
+			return
+		}
+		statuses = append(statuses, models.OrderStatusFromProto(proto.OrderStatus(value)))
+	}
+	page, err := pageRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	orders, next, err := h.uc.GetCustomerOrders(r.Context(), customerId, statuses, page)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	res := &proto.GetCustomerOrdersRes{OrderList: make([]*proto.Order, 0), NextPageToken: next}
+	for _, o := range orders {
+		res.OrderList = append(res.OrderList, o.ToProto())
+	}
+	h.write(w, http.StatusOK, res)
+}
+
+// addOrder handles POST /v1/orders with AddOrderReq body
+func (h *dvdstoreHandler) addOrder(w http.ResponseWriter, r *http.Request) {
+	req := &proto.AddOrderReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	products := make([]*models.Product, 0)
+	for _, p := range req.GetProductList() {
+		products = append(products, models.ProductFromProto(p))
+	}
+
+	order, err := h.uc.AddOrder(r.Context(), int(req.GetCustomerID()), products, int(req.GetPaymentMethodID()),
+		req.GetIdempotencyKey())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.AddOrderRes{OrderID: int64(order.Id)})
+}
+
+// cancelOrder handles POST /v1/orders/{id}/cancel
+func (h *dvdstoreHandler) cancelOrder(w http.ResponseWriter, r *http.Request) {
+	orderId, err := pathId(r, "orderId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if err := h.uc.CancelOrder(r.Context(), orderId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.CancelOrderRes{})
+}
+
+// transitionOrder handles POST /v1/orders/{id}/transition with {"Status": "..."} body
+func (h *dvdstoreHandler) transitionOrder(w http.ResponseWriter, r *http.Request) {
+	orderId, err := pathId(r, "orderId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.TransitionOrderReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	order, err := h.uc.TransitionOrder(r.Context(), orderId, models.OrderStatusFromProto(req.GetStatus()))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.TransitionOrderRes{Order: order.ToProto()})
+}
+
+// returnOrderLines handles POST /v1/orders/{id}/returns with {"ProductList": [...]} body
+func (h *dvdstoreHandler) returnOrderLines(w http.ResponseWriter, r *http.Request) {
+	orderId, err := pathId(r, "orderId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.ReturnOrderLinesReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	products := make([]*models.Product, 0)
+	for _, p := range req.GetProductList() {
+		products = append(products, models.ProductFromProto(p))
+	}
+
+	ret, err := h.uc.ReturnOrderLines(r.Context(), orderId, products)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.ReturnOrderLinesRes{Return: ret.ToProto()})
+}
+
+// deleteOrder handles DELETE /v1/orders/{id}
+func (h *dvdstoreHandler) deleteOrder(w http.ResponseWriter, r *http.Request) {
+	orderId, err := pathId(r, "orderId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if err := h.uc.DeleteOrder(r.Context(), orderId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.DeleteOrderRes{})
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+)
+
+// getPaymentMethods handles GET /v1/customers/{id}/payment-methods
+func (h *dvdstoreHandler) getPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	methods, err := h.uc.GetPaymentMethods(r.Context(), customerId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	res := &proto.GetPaymentMethodsRes{PaymentMethodList: make([]*proto.PaymentMethod, 0, len(methods))}
+	for _, m := range methods {
+		res.PaymentMethodList = append(res.PaymentMethodList, m.ToProto())
+	}
+	h.write(w, http.StatusOK, res)
+}
+
+// addPaymentMethod handles POST /v1/customers/{id}/payment-methods with {"Card": {...}} body
+func (h *dvdstoreHandler) addPaymentMethod(w http.ResponseWriter, r *http.Request) {
+	customerId, err := pathId(r, "customerId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.AddPaymentMethodReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	method, err := h.uc.AddPaymentMethod(r.Context(), customerId, models.CardFromProto(req.GetCard()))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.AddPaymentMethodRes{PaymentMethod: method.ToProto()})
+}
+
+// deletePaymentMethod handles DELETE /v1/payment-methods/{id}
+func (h *dvdstoreHandler) deletePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	paymentMethodId, err := pathId(r, "paymentMethodId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if err := h.uc.DeletePaymentMethod(r.Context(), paymentMethodId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.DeletePaymentMethodRes{})
+}
@@ -0,0 +1,235 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/proto"
+)
+
+// getProducts handles GET /v1/products?limit=&pageToken=&sortBy=
+func (h *dvdstoreHandler) getProducts(w http.ResponseWriter, r *http.Request) {
+	page, err := pageRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	products, next, err := h.uc.GetProducts(r.Context(), page)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.GetProductsRes{ProductList: productsProto(products),
+		NextPageToken: next})
+}
+
+// searchProducts handles GET /v1/products/search?query=&category=&minPrice=&maxPrice=
+// &inStockOnly=&limit=&pageToken=&sortBy=. Category may be repeated, prices are in cents
+func (h *dvdstoreHandler) searchProducts(w http.ResponseWriter, r *http.Request) {
+	filter, err := productFilter(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	page, err := pageRequest(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	products, next, err := h.uc.SearchProducts(r.Context(), filter, page)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.SearchProductsRes{ProductList: productsProto(products),
+		NextPageToken: next})
+}
+
+// getProduct handles GET /v1/products/{id}
+func (h *dvdstoreHandler) getProduct(w http.ResponseWriter, r *http.Request) {
+	productId, err := pathId(r, "productId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	product, err := h.uc.GetProduct(r.Context(), productId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.GetProductRes{Product: product.ToProto()})
+}
+
+// addProduct handles POST /v1/products with Product body
+func (h *dvdstoreHandler) addProduct(w http.ResponseWriter, r *http.Request) {
+	req := &proto.Product{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if !models.ValidCurrency(req.GetPrice()) {
+		h.writeError(w, r, models.ErrCurrencyNotValid("price"))
+		return
+	}
+
+	id, err := h.uc.AddProduct(r.Context(), models.ProductFromProto(req))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.AddProductRes{ProductID: int64(id)})
+}
+
+// updateProduct handles PATCH /v1/products/{id}?updateMask= with Product body.
+// Update mask is a comma separated list of fields, all updatable fields are updated if empty
+func (h *dvdstoreHandler) updateProduct(w http.ResponseWriter, r *http.Request) {
+	productId, err := pathId(r, "productId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.Product{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if !models.ValidCurrency(req.GetPrice()) {
+		h.writeError(w, r, models.ErrCurrencyNotValid("price"))
+		return
+	}
+	req.Id = int64(productId)
+
+	fields := service.MaskFields(updateMask(r), req)
+	updated, err := h.uc.UpdateProduct(r.Context(), models.ProductFromProto(req), fields)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.UpdateProductRes{Product: updated.ToProto()})
+}
+
+// adjustInventory handles POST /v1/products/{id}/inventory with {"Delta": n} body
+func (h *dvdstoreHandler) adjustInventory(w http.ResponseWriter, r *http.Request) {
+	productId, err := pathId(r, "productId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	req := &proto.AdjustInventoryReq{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	product, err := h.uc.AdjustInventory(r.Context(), productId, int(req.GetDelta()))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.AdjustInventoryRes{Product: product.ToProto()})
+}
+
+// deleteProduct handles DELETE /v1/products/{id}
+func (h *dvdstoreHandler) deleteProduct(w http.ResponseWriter, r *http.Request) {
+	productId, err := pathId(r, "productId")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if err := h.uc.DeleteProduct(r.Context(), productId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusOK, &proto.DeleteProductRes{})
+}
+
+// listCategories handles GET /v1/categories
+func (h *dvdstoreHandler) listCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.uc.ListCategories(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	res := &proto.ListCategoriesRes{CategoryList: make([]*proto.Category, 0, len(categories))}
+	for _, c := range categories {
+		res.CategoryList = append(res.CategoryList, c.ToProto())
+	}
+	h.write(w, http.StatusOK, res)
+}
+
+// addCategory handles POST /v1/categories with Category body
+func (h *dvdstoreHandler) addCategory(w http.ResponseWriter, r *http.Request) {
+	req := &proto.Category{}
+	if err := decode(w, r, req); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	id, err := h.uc.AddCategory(r.Context(), models.CategoryFromProto(req))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.write(w, http.StatusCreated, &proto.AddCategoryRes{CategoryID: int64(id)})
+}
+
+// productFilter returns product search filter from query parameters
+func productFilter(r *http.Request) (*models.ProductFilter, error) {
+	query := r.URL.Query()
+	filter := &models.ProductFilter{Query: query.Get("query")}
+
+	for _, c := range query["category"] {
+		category, err := strconv.Atoi(c)
+		if err != nil {
+			return nil, models.ErrFieldsNotValid("category")
+		}
+		filter.Categories = append(filter.Categories, category)
+	}
+	for name, price := range map[string]**models.Money{
+		"minPrice": &filter.MinPrice,
+		"maxPrice": &filter.MaxPrice,
+	} {
+		if query.Get(name) == "" {
+			continue
+		}
+		amount, err := strconv.ParseInt(query.Get(name), 10, 64)
+		if err != nil {
+			return nil, models.ErrFieldsNotValid(name)
+		}
+		money := models.Money(amount)
+		*price = &money
+	}
+	if value := query.Get("inStockOnly"); value != "" {
+		inStockOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, models.ErrFieldsNotValid("inStockOnly")
+		}
+		filter.InStockOnly = inStockOnly
+	}
+
+	return filter, nil
+}
+
+// productsProto maps products to grpc messages
+func productsProto(products []*models.Product) []*proto.Product {
+	res := make([]*proto.Product, 0, len(products))
+	for _, p := range products {
+		res = append(res, p.ToProto())
+	}
+	return res
+}
@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"github.com/alexzh7/sample-service/internal/logging"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+// maxBodySize is a max size of request body in bytes
+const maxBodySize = 1 << 20
+
+// dvdstoreHandler is a REST/JSON transport for dvd store. Request and response bodies
+// are JSON mapping of the grpc messages, so both transports share the same schema
+type dvdstoreHandler struct {
+	uc     dvdstore.Usecase
+	tokens dvdstore.TokenManager
+	keys   dvdstore.APIKeys
+	log    *zap.SugaredLogger
+}
+
+// NewDvdstoreHandler returns http handler serving dvd store API under /v1
+func NewDvdstoreHandler(uc dvdstore.Usecase, tokens dvdstore.TokenManager, keys dvdstore.APIKeys,
+	log *zap.SugaredLogger) http.Handler {
+	h := &dvdstoreHandler{uc: uc, tokens: tokens, keys: keys, log: log}
+
+	r := chi.NewRouter()
+	r.Use(logging.Middleware(log))
+	r.Use(h.authenticate)
+	r.Route("/v1", func(r chi.Router) {
+		r.With(h.authorize("Login")).Post("/login", h.login)
+		r.Route("/customers", func(r chi.Router) {
+			r.With(h.authorize("GetCustomers")).Get("/", h.getCustomers)
+			r.With(h.authorize("AddCustomer")).Post("/", h.addCustomer)
+			r.With(h.authorize("GetCustomer")).Get("/{id}", h.getCustomer)
+			r.With(h.authorize("UpdateCustomer")).Patch("/{id}", h.updateCustomer)
+			r.With(h.authorize("DeleteCustomer")).Delete("/{id}", h.deleteCustomer)
+			r.With(h.authorize("GetCustomerOrders")).Get("/{id}/orders", h.getCustomerOrders)
+			r.With(h.authorize("GetPaymentMethods")).Get("/{id}/payment-methods", h.getPaymentMethods)
+			r.With(h.authorize("AddPaymentMethod")).Post("/{id}/payment-methods", h.addPaymentMethod)
+			r.With(h.authorize("GetCart")).Get("/{id}/cart", h.getCart)
+			r.With(h.authorize("AddToCart")).Post("/{id}/cart/items", h.addToCart)
+			r.With(h.authorize("RemoveFromCart")).Delete("/{id}/cart/items/{productId}", h.removeFromCart)
+			r.With(h.authorize("Checkout")).Post("/{id}/cart/checkout", h.checkout)
+		})
+		r.Route("/products", func(r chi.Router) {
+			r.With(h.authorize("GetProducts")).Get("/", h.getProducts)
+			r.With(h.authorize("AddProduct")).Post("/", h.addProduct)
+			r.With(h.authorize("SearchProducts")).Get("/search", h.searchProducts)
+			r.With(h.authorize("GetProduct")).Get("/{id}", h.getProduct)
+			r.With(h.authorize("UpdateProduct")).Patch("/{id}", h.updateProduct)
+			r.With(h.authorize("DeleteProduct")).Delete("/{id}", h.deleteProduct)
+			r.With(h.authorize("AdjustInventory")).Post("/{id}/inventory", h.adjustInventory)
+		})
+		r.Route("/categories", func(r chi.Router) {
+			r.With(h.authorize("ListCategories")).Get("/", h.listCategories)
+			r.With(h.authorize("AddCategory")).Post("/", h.addCategory)
+		})
+		r.Route("/orders", func(r chi.Router) {
+			r.With(h.authorize("AddOrder")).Post("/", h.addOrder)
+			r.With(h.authorize("GetOrder")).Get("/{id}", h.getOrder)
+			r.With(h.authorize("DeleteOrder")).Delete("/{id}", h.deleteOrder)
+			r.With(h.authorize("CancelOrder")).Post("/{id}/cancel", h.cancelOrder)
+			r.With(h.authorize("TransitionOrder")).Post("/{id}/transition", h.transitionOrder)
+			r.With(h.authorize("ReturnOrderLines")).Post("/{id}/returns", h.returnOrderLines)
+		})
+		r.With(h.authorize("DeletePaymentMethod")).Delete("/payment-methods/{id}", h.deletePaymentMethod)
+	})
+
+	return r
+}
+
+// authenticate is a middleware that verifies bearer token from Authorization header or
+// API key from X-Api-Key header and puts identity of the caller to the request context.
+// Requests without credentials are passed anonymous, requests with invalid ones are rejected
+func (h *dvdstoreHandler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, err := service.Authenticate(h.tokens, h.keys, r.Header.Get("Authorization"),
+			r.Header.Get(service.APIKeyHeader))
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		if identity != nil {
+			r = r.WithContext(models.WithIdentity(r.Context(), identity))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authorize returns a middleware that checks that the caller may call the grpc method
+// served by the route, so both transports share the same permissions
+func (h *dvdstoreHandler) authorize(method string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := service.Authorize(r.Context(), method); err != nil {
+				h.writeError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// decode reads request body into passed grpc message. Returns ValidationError
+// if body is not a valid JSON of the message
+func decode(w http.ResponseWriter, r *http.Request, msg protoV2.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		return &models.ValidationError{Message: fmt.Sprintf("can't read request body: %v", err)}
+	}
+	if err := protojson.Unmarshal(body, msg); err != nil {
+		return &models.ValidationError{Message: fmt.Sprintf("request body is not valid: %v", err)}
+	}
+	return nil
+}
+
+// write writes passed grpc message as JSON response with provided http status
+func (h *dvdstoreHandler) write(w http.ResponseWriter, status int, msg protoV2.Message) {
+	body, err := protojson.Marshal(msg)
+	if err != nil {
+		h.log.Errorf("protojson.Marshal: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		h.log.Debugf("ResponseWriter.Write: %v", err)
+	}
+}
+
+// pathId returns id from the request path. Returns ValidationError if id is not a number
+func pathId(r *http.Request, name string) (int, error) {
+	return pathInt(r, "id", name)
+}
+
+// pathInt returns integer URL parameter of the request path. Returns ValidationError if
+// parameter is not a number
+func pathInt(r *http.Request, param, name string) (int, error) {
+	id, err := strconv.Atoi(chi.URLParam(r, param))
+	if err != nil {
+		return 0, &models.ValidationError{Message: fmt.Sprintf("%v must be a number", name)}
+	}
+	return id, nil
+}
+
+// queryInt returns integer query parameter or 0 if it's absent. Returns ValidationError
+// if parameter is not a number
+func queryInt(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, &models.ValidationError{Message: fmt.Sprintf("%v must be a number", name)}
+	}
+	return i, nil
+}
+
+// pageRequest returns page request from "limit", "pageToken" and "sortBy" query parameters
+func pageRequest(r *http.Request) (models.PageRequest, error) {
+	limit, err := queryInt(r, "limit")
+	if err != nil {
+		return models.PageRequest{}, err
+	}
+
+	query := r.URL.Query()
+	return models.PageRequest{
+		Limit:     limit,
+		SortBy:    query.Get("sortBy"),
+		PageToken: query.Get("pageToken"),
+	}, nil
+}
@@ -0,0 +1,275 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// mockUsecase implements methods of dvdstore.Usecase used in tests,
+// other methods panic
+type mockUsecase struct {
+	dvdstore.Usecase
+	customers map[int]*models.Customer
+	added     []*models.Product
+	method    int
+	key       string
+	card      *models.Card
+	identity  *models.Identity
+}
+
+// mockTokens accepts only "valid" token of customer 5, "staff" and "admin" tokens of
+// staff customer 1 and admin customer 2
+type mockTokens struct{}
+
+func (mockTokens) Issue(customerId int, role models.Role) (string, time.Time, error) {
+	return "valid", time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC), nil
+}
+
+func (mockTokens) Verify(token string) (*models.Identity, error) {
+	switch token {
+	case "valid":
+		return &models.Identity{CustomerId: 5, Role: models.RoleCustomer}, nil
+	case "staff":
+		return &models.Identity{CustomerId: 1, Role: models.RoleStaff}, nil
+	case "admin":
+		return &models.Identity{CustomerId: 2, Role: models.RoleAdmin}, nil
+	}
+	return nil, &models.AuthError{Message: "invalid token"}
+}
+
+// mockKeys accepts only "reporting-key" of reporting service
+type mockKeys struct{}
+
+func (mockKeys) Verify(key string) (*models.Identity, error) {
+	if key != "reporting-key" {
+		return nil, &models.AuthError{Message: "invalid api key"}
+	}
+	return &models.Identity{Name: "reporting", Role: models.RoleService}, nil
+}
+
+func (m *mockUsecase) GetCustomer(ctx context.Context, customerId int) (*models.Customer, error) {
+	if c, ok := m.customers[customerId]; ok {
+		return c, nil
+	}
+	return nil, models.ErrNotFound("customer", customerId)
+}
+
+func (m *mockUsecase) AddOrder(ctx context.Context, customerId int, products []*models.Product,
+	paymentMethodId int, idempotencyKey string) (*models.Order, error) {
+	m.added, m.method, m.key = products, paymentMethodId, idempotencyKey
+	return &models.Order{Id: 12010}, nil
+}
+
+func (m *mockUsecase) AddPaymentMethod(ctx context.Context, customerId int,
+	card *models.Card) (*models.PaymentMethod, error) {
+	m.card = card
+	return &models.PaymentMethod{Id: 3, CustomerId: customerId, Token: "tok_secret", Brand: card.Brand(),
+		LastFour: card.LastFour(), ExpMonth: card.ExpMonth, ExpYear: card.ExpYear}, nil
+}
+
+func (m *mockUsecase) GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus,
+	page models.PageRequest) ([]*models.Order, string, error) {
+	m.identity, _ = models.IdentityFromContext(ctx)
+	return nil, "", nil
+}
+
+func (m *mockUsecase) Login(ctx context.Context, credentials *models.Credentials) (string, time.Time, error) {
+	if credentials.Username != "johndoe" || credentials.Password != "password1" {
+		return "", time.Time{}, models.ErrInvalidCredentials
+	}
+	return mockTokens{}.Issue(5, models.RoleCustomer)
+}
+
+func (m *mockUsecase) DeleteProduct(ctx context.Context, productId int) error {
+	return nil
+}
+
+func (m *mockUsecase) RemoveFromCart(ctx context.Context, customerId, productId int) (*models.Cart, error) {
+	if productId != 34 {
+		return nil, &models.EntityError{Entity: "product", Message: "id 35 not found in cart of customer 5"}
+	}
+	return &models.Cart{CustomerId: customerId, Items: []*models.CartItem{
+		{Product: &models.Product{Id: 7, Price: 999, Quantity: 1}, Quantity: 2}}}, nil
+}
+
+func newTestHandler() (http.Handler, *mockUsecase) {
+	uc := &mockUsecase{customers: map[int]*models.Customer{
+		5: {Id: 5, FirstName: "John", LastName: "Doe", Age: 40},
+	}}
+	return NewDvdstoreHandler(uc, mockTokens{}, mockKeys{}, zap.NewNop().Sugar()), uc
+}
+
+// customerRequest returns request authenticated as customer 5
+func customerRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer valid")
+	return req
+}
+
+func TestGetCustomer(t *testing.T) {
+	h, _ := newTestHandler()
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/v1/customers/5", http.StatusOK, `"FirstName":"John"`},
+		{"/v1/customers/7", http.StatusNotFound, `"message":"customer id 7 not found"`},
+		{"/v1/customers/abc", http.StatusBadRequest, `customerId must be a number`},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, customerRequest(http.MethodGet, tt.path, ""))
+		assert.Equal(t, tt.status, rec.Code, tt.path)
+		assert.Contains(t, strings.ReplaceAll(rec.Body.String(), " ", ""),
+			strings.ReplaceAll(tt.body, " ", ""), tt.path)
+	}
+}
+
+func TestAddOrder(t *testing.T) {
+	h, uc := newTestHandler()
+
+	body := `{"CustomerID": 5, "ProductList": [{"Id": 34, "Quantity": 2}], "PaymentMethodID": 3,
+		"IdempotencyKey": "k1"}`
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, customerRequest(http.MethodPost, "/v1/orders", body))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"OrderID":"12010"`)
+	assert.Equal(t, "k1", uc.key)
+	assert.Equal(t, 3, uc.method)
+	assert.Equal(t, []*models.Product{{Id: 34, Quantity: 2}}, uc.added)
+}
+
+func TestAddPaymentMethod(t *testing.T) {
+	h, uc := newTestHandler()
+
+	body := `{"Card": {"Number": "4242424242424242", "ExpMonth": 12, "ExpYear": 2030, "Cvc": "123"}}`
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, customerRequest(http.MethodPost, "/v1/customers/5/payment-methods", body))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, &models.Card{Number: "4242424242424242", ExpMonth: 12, ExpYear: 2030, Cvc: "123"}, uc.card)
+	res := strings.ReplaceAll(rec.Body.String(), " ", "")
+	assert.Contains(t, res, `"LastFour":"4242"`)
+	assert.Contains(t, res, `"Brand":"visa"`)
+	// Neither card number nor token are exposed
+	assert.NotContains(t, res, "4242424242424242")
+	assert.NotContains(t, res, "tok_secret")
+}
+
+func TestRemoveFromCart(t *testing.T) {
+	h, _ := newTestHandler()
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/v1/customers/5/cart/items/34", http.StatusOK,
+			`"Quantity":"2"}],"NetAmount":{"Currency":"USD","Amount":"1998"}`},
+		{"/v1/customers/5/cart/items/35", http.StatusNotFound, `id 35 not found in cart`},
+		{"/v1/customers/5/cart/items/abc", http.StatusBadRequest, `productId must be a number`},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, customerRequest(http.MethodDelete, tt.path, ""))
+		assert.Equal(t, tt.status, rec.Code, tt.path)
+		assert.Contains(t, strings.ReplaceAll(rec.Body.String(), " ", ""),
+			strings.ReplaceAll(tt.body, " ", ""), tt.path)
+	}
+}
+
+func TestAddOrderInvalidBody(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, customerRequest(http.MethodPost, "/v1/orders", `{"Unknown": 1}`))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestLogin(t *testing.T) {
+	h, _ := newTestHandler()
+
+	tests := []struct {
+		body   string
+		status int
+		res    string
+	}{
+		{`{"Username": "johndoe", "Password": "password1"}`, http.StatusOK, `"Token":"valid"`},
+		{`{"Username": "johndoe", "Password": "wrong"}`, http.StatusUnauthorized, `"code":16`},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/login", strings.NewReader(tt.body)))
+		assert.Equal(t, tt.status, rec.Code, tt.body)
+		assert.Contains(t, strings.ReplaceAll(rec.Body.String(), " ", ""), tt.res, tt.body)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		authorization string
+		status        int
+		identity      *models.Identity
+	}{
+		{"", http.StatusUnauthorized, nil},
+		{"Bearer valid", http.StatusOK, &models.Identity{CustomerId: 5, Role: models.RoleCustomer}},
+		{"Bearer expired", http.StatusUnauthorized, nil},
+		{"Basic am9objpwYXNz", http.StatusUnauthorized, nil},
+	}
+
+	for _, tt := range tests {
+		h, uc := newTestHandler()
+		req := httptest.NewRequest(http.MethodGet, "/v1/customers/5/orders", nil)
+		if tt.authorization != "" {
+			req.Header.Set("Authorization", tt.authorization)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, tt.status, rec.Code, tt.authorization)
+		assert.Equal(t, tt.identity, uc.identity, tt.authorization)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		status int
+	}{
+		{"anonymous", "", "", http.StatusUnauthorized},
+		{"customer", "Authorization", "Bearer valid", http.StatusForbidden},
+		{"service", service.APIKeyHeader, "reporting-key", http.StatusForbidden},
+		{"invalid api key", service.APIKeyHeader, "wrong", http.StatusUnauthorized},
+		{"staff", "Authorization", "Bearer staff", http.StatusForbidden},
+		{"admin", "Authorization", "Bearer admin", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		h, _ := newTestHandler()
+		req := httptest.NewRequest(http.MethodDelete, "/v1/products/34", nil)
+		if tt.header != "" {
+			req.Header.Set(tt.header, tt.value)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, tt.status, rec.Code, tt.name)
+	}
+}
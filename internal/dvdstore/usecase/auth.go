@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// dummyPasswordHash is bcrypt hash compared with password of unknown username, so login of
+// unknown and known customers takes the same time
+const dummyPasswordHash = "$2a$10$USAzUKX2pTNxHhAD5bAMSu0FX.irxbJQ60.23V1Ek3Zn/t10WraiC"
+
+// Login checks customer credentials and returns signed customer token carrying his role
+// with its expiration time. Returns AuthError if credentials are wrong, ErrInternal if token
+// wasn't issued and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) Login(ctx context.Context, credentials *models.Credentials) (token string,
+	expiresAt time.Time, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.Login")
+	defer span.End()
+
+	if err := d.validate.Struct(credentials); err != nil {
+		d.logger(ctx).Debugf("Login validate.Struct: %v", err)
+		return "", time.Time{}, models.ErrInvalidCredentials
+	}
+
+	customerId, stored, role, err := d.pg.GetCustomerCredentials(ctx, credentials.Username)
+	if err != nil {
+		var entErr *models.EntityError
+		if errors.As(err, &entErr) {
+			checkPassword(dummyPasswordHash, credentials.Password)
+			return "", time.Time{}, models.ErrInvalidCredentials
+		}
+		d.logger(ctx).Error(err)
+		return "", time.Time{}, models.ErrGeneralDBFail
+	}
+
+	ok, legacy := checkPassword(stored, credentials.Password)
+	if !ok {
+		return "", time.Time{}, models.ErrInvalidCredentials
+	}
+	// Replace plain text password of sample database customer with its hash
+	if legacy {
+		if err := d.rehashPassword(ctx, customerId, credentials.Password); err != nil {
+			d.logger(ctx).Warnf("Login rehash password of customer %v: %v", customerId, err)
+		}
+	}
+
+	token, expiresAt, err = d.tokens.Issue(customerId, role)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return "", time.Time{}, models.ErrInternal
+	}
+	return token, expiresAt, nil
+}
+
+// rehashPassword saves bcrypt hash of the customer password
+func (d *dvdstoreUC) rehashPassword(ctx context.Context, customerId int, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return d.pg.UpdateCustomerPassword(ctx, customerId, hash)
+}
+
+// hashPassword returns bcrypt hash of the password
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// checkPassword compares password with stored bcrypt hash. Customers of sample database
+// have plain text passwords, legacy reports that such password matched. Empty stored
+// password of customers added before registration never matches
+func checkPassword(stored, password string) (ok, legacy bool) {
+	if strings.HasPrefix(stored, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, false
+	}
+	ok = stored != "" && subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+	return ok, ok
+}
+
+// authorizeCustomer checks that the caller may work with data of the customer. Staff, admins
+// and services work with any customer, customers only with their own data. Returns AuthError
+// for anonymous caller and PermissionError for another customer
+func authorizeCustomer(ctx context.Context, customerId int) error {
+	identity, ok := models.IdentityFromContext(ctx)
+	if !ok {
+		return models.ErrUnauthenticated
+	}
+	if identity.Role != models.RoleCustomer {
+		return nil
+	}
+	if identity.CustomerId != customerId {
+		return models.ErrPermissionDenied("customer", customerId)
+	}
+	return nil
+}
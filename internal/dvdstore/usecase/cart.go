@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// GetCart returns cart of the customer with current prices and quantities in stock. Customers
+// get only their own cart, expired cart is emptied. Returns AuthError if caller is not
+// authenticated, PermissionError if caller is another customer and ErrGeneralDBFail if db
+// returned db-specific error
+func (d *dvdstoreUC) GetCart(ctx context.Context, customerId int) (*models.Cart, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetCart")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("GetCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+
+	return d.cart(ctx, customerId)
+}
+
+// maxCartQuantity is a max quantity of the product in the cart, the same as max quantity of
+// the order line
+const maxCartQuantity = math.MaxInt32
+
+// AddToCart adds quantity of the product to the customer cart and returns the cart. Stock is
+// not reserved, availability is shown by the cart and checked on checkout. Customers change
+// only their own cart. Returns ValidationError if request is not valid or quantity of the
+// product in the cart exceeds maxCartQuantity, AuthError if caller is not authenticated,
+// PermissionError if caller is another customer, EntityError if customer or product wasn't
+// found and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AddToCart(ctx context.Context, customerId, productId, quantity int) (*models.Cart, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddToCart")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("AddToCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := validateVar(productId, "productId"); err != nil {
+		d.logger(ctx).Debugf("AddToCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := validateVar(quantity, "quantity"); err != nil {
+		d.logger(ctx).Debugf("AddToCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+
+	// Items of expired cart are not added up with the new one
+	cart, err := d.cart(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+	inCart := 0
+	for _, item := range cart.Items {
+		if item.Product.Id == productId {
+			inCart = item.Quantity
+		}
+	}
+	if quantity > maxCartQuantity-inCart {
+		return nil, &models.ValidationError{
+			Message: fmt.Sprintf("quantity in cart must be <= %v", maxCartQuantity),
+		}
+	}
+	if err := d.pg.AddCartItem(ctx, customerId, productId, quantity); err != nil {
+		var entErr *models.EntityError
+		if errors.As(err, &entErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return d.cart(ctx, customerId)
+}
+
+// RemoveFromCart removes the product from the customer cart and returns the cart. Customers
+// change only their own cart. Returns ValidationError if request is not valid, AuthError if
+// caller is not authenticated, PermissionError if caller is another customer, EntityError if
+// product is not in the cart and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) RemoveFromCart(ctx context.Context, customerId, productId int) (*models.Cart, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.RemoveFromCart")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("RemoveFromCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := validateVar(productId, "productId"); err != nil {
+		d.logger(ctx).Debugf("RemoveFromCart validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+
+	// Product of expired cart is not in the cart anymore
+	if _, err := d.cart(ctx, customerId); err != nil {
+		return nil, err
+	}
+	if err := d.pg.RemoveCartItem(ctx, customerId, productId); err != nil {
+		var entErr *models.EntityError
+		if errors.As(err, &entErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return d.cart(ctx, customerId)
+}
+
+// Checkout places order of the customer cart items paid with the payment method the same way
+// as AddOrder and removes ordered items from the cart, items added meanwhile are kept. If
+// idempotency key is not empty and was already used with the same checkout, the order created
+// by that checkout is returned. Returns order and the same errors as AddOrder, ConflictError if
+// the key was used with another customer, payment method or cart and ValidationError if the
+// cart is empty
+func (d *dvdstoreUC) Checkout(ctx context.Context, customerId, paymentMethodId int,
+	idempotencyKey string) (*models.Order, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.Checkout")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("Checkout validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+	if err := validateIdempotencyKey(idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	cart, err := d.cart(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+	products := cart.Products()
+
+	// Return the order of the replayed checkout. Its items are already removed from the cart,
+	// so retry with empty cart is matched against the items of the order
+	var key *models.IdempotencyKey
+	if idempotencyKey != "" {
+		checkedOut := products
+		if len(checkedOut) == 0 {
+			if checkedOut, err = d.checkedOutProducts(ctx, idempotencyKey); err != nil {
+				return nil, err
+			}
+		}
+		key = &models.IdempotencyKey{
+			Key:         idempotencyKey,
+			RequestHash: checkoutRequestHash(customerId, checkedOut, paymentMethodId),
+			ExpiresAt:   time.Now().Add(d.idempotencyRetention),
+		}
+		if order, err := d.replayOrder(ctx, key); order != nil || err != nil {
+			return order, err
+		}
+	}
+
+	if len(products) == 0 {
+		return nil, &models.ValidationError{Message: "cart is empty"}
+	}
+
+	order, err := d.placeOrder(ctx, customerId, products, paymentMethodId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order is placed, so failure to remove ordered items is only logged
+	if err := d.pg.SubtractCartItems(ctx, customerId, products); err != nil {
+		d.logger(ctx).Errorf("Checkout cart of customer %v: %v", customerId, err)
+	}
+
+	return order, nil
+}
+
+// cart returns cart of the customer with its expiry time. Expired cart is deleted and empty
+// cart is returned. Returns ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) cart(ctx context.Context, customerId int) (*models.Cart, error) {
+	cart, err := d.pg.GetCart(ctx, customerId)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+	if len(cart.Items) == 0 {
+		return cart, nil
+	}
+
+	cart.ExpiresAt = cart.UpdatedAt.Add(d.cartExpiry)
+	if cart.ExpiresAt.After(time.Now()) {
+		return cart, nil
+	}
+	if err := d.pg.DeleteCart(ctx, customerId); err != nil {
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+	return &models.Cart{CustomerId: customerId, Items: make([]*models.CartItem, 0)}, nil
+}
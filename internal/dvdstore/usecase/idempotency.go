@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// maxIdempotencyKeyLen is a max length of AddOrder and Checkout idempotency key
+const maxIdempotencyKeyLen = 100
+
+// validateIdempotencyKey returns ValidationError if idempotency key is too long
+func validateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLen {
+		return &models.ValidationError{
+			Message: fmt.Sprintf("idempotencyKey must be at most %v characters", maxIdempotencyKeyLen),
+		}
+	}
+	return nil
+}
+
+// replayOrder returns order created by the request with passed idempotency key. Returns nil
+// order and nil error if key was not used yet, ConflictError if key was used with another
+// request and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) replayOrder(ctx context.Context, key *models.IdempotencyKey) (*models.Order, error) {
+	stored, err := d.pg.GetIdempotencyKey(ctx, key.Key)
+	if err != nil {
+		var entErr *models.EntityError
+		if errors.As(err, &entErr) {
+			return nil, nil
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	if stored.RequestHash != key.RequestHash {
+		return nil, models.ErrKeyReused(key.Key)
+	}
+
+	d.logger(ctx).Debugf("Replayed order %v for idempotency key %q", stored.OrderId, key.Key)
+	return d.GetOrder(ctx, stored.OrderId)
+}
+
+// checkedOutProducts returns products of the order created by the checkout with passed
+// idempotency key. Returns nil products if key was not used yet and ErrGeneralDBFail if db
+// returned db-specific error
+func (d *dvdstoreUC) checkedOutProducts(ctx context.Context, key string) ([]*models.Product, error) {
+	stored, err := d.pg.GetIdempotencyKey(ctx, key)
+	if err == nil {
+		var order *models.Order
+		if order, err = d.pg.GetOrder(ctx, stored.OrderId); err == nil {
+			return order.Products, nil
+		}
+	}
+	var entErr *models.EntityError
+	if errors.As(err, &entErr) {
+		return nil, nil
+	}
+	d.logger(ctx).Error(err)
+	return nil, models.ErrGeneralDBFail
+}
+
+// orderRequestHash returns hex encoded sha256 of the order request. Products are
+// summed up by id, so the order of products in the request doesn't change the hash
+func orderRequestHash(customerId int, products []*models.Product, paymentMethodId int) string {
+	return requestHash(fmt.Sprintf("customer:%v;payment method:%v", customerId, paymentMethodId), products)
+}
+
+// checkoutRequestHash returns hex encoded sha256 of the checkout request with checked out
+// cart items, so the key reused with another cart is rejected
+func checkoutRequestHash(customerId int, products []*models.Product, paymentMethodId int) string {
+	return requestHash(fmt.Sprintf("checkout;customer:%v;payment method:%v", customerId, paymentMethodId),
+		products)
+}
+
+// requestHash returns hex encoded sha256 of the request prefix followed by products summed
+// up by id and sorted by id
+func requestHash(prefix string, products []*models.Product) string {
+	quantities := make(map[int]int, len(products))
+	productIds := make([]int, 0, len(products))
+	for _, p := range products {
+		if _, ok := quantities[p.Id]; !ok {
+			productIds = append(productIds, p.Id)
+		}
+		quantities[p.Id] += p.Quantity
+	}
+	sort.Ints(productIds)
+
+	h := sha256.New()
+	fmt.Fprint(h, prefix)
+	for _, id := range productIds {
+		fmt.Fprintf(h, ";product:%v:%v", id, quantities[id])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
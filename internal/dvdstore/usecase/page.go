@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// defaultOrdersLimit is a limit of orders on the page if limit was not provided
+const defaultOrdersLimit = 100
+
+// pageCursor is a helper function that validates page request against allowed sort fields
+// and returns sort field and decoded cursor. Sort field is "id" if it is empty, cursor is nil
+// for the first page. Returns ValidationError if page request is not valid
+func pageCursor(page models.PageRequest, sortFields []string) (string, *models.Cursor, error) {
+	if err := validateVar(page.Limit, "limit"); err != nil {
+		return "", nil, err
+	}
+
+	sortBy := page.SortBy
+	if sortBy == "" {
+		sortBy = models.SortId
+	}
+	if !contains(sortFields, sortBy) {
+		return "", nil, &models.ValidationError{
+			Message: fmt.Sprintf("sortBy must be one of %q", sortFields),
+		}
+	}
+
+	if page.PageToken == "" {
+		return sortBy, nil, nil
+	}
+	after, err := models.DecodePageToken(page.PageToken)
+	if err != nil {
+		return "", nil, err
+	}
+	if after.SortBy != sortBy {
+		return "", nil, &models.ValidationError{Message: "pageToken was issued for another sortBy"}
+	}
+
+	return sortBy, after, nil
+}
+
+// pageToken is a helper function that returns token of the page going after the item
+func pageToken(sortBy string, sortValue string, id int) string {
+	return models.EncodePageToken(&models.Cursor{SortBy: sortBy, Value: sortValue, Id: id})
+}
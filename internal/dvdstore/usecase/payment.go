@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// cardFields are validated fields of payment card
+var cardFields = []string{"Number", "ExpMonth", "ExpYear", "Cvc"}
+
+// AddPaymentMethod tokenizes card and registers it as a payment method of the customer.
+// Customers add cards only for themselves. Returns payment method and errors: ValidationError
+// if card is not valid, expired or declined, AuthError if caller is not authenticated,
+// PermissionError if caller is another customer, EntityError if customer wasn't found,
+// ErrPaymentFail if tokenizer failed and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AddPaymentMethod(ctx context.Context, customerId int,
+	card *models.Card) (*models.PaymentMethod, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddPaymentMethod")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("AddPaymentMethod validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+	if err := d.validate.Struct(card); err != nil {
+		d.logger(ctx).Debugf("AddPaymentMethod validate.Struct: %v", err)
+		return nil, models.ErrFieldsNotValid(invalidFields(err, cardFields)...)
+	}
+	if card.Expired(time.Now()) {
+		return nil, &models.ValidationError{Message: "card is expired"}
+	}
+
+	token, err := d.tokenizer.Tokenize(ctx, card)
+	if err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrPaymentFail
+	}
+
+	method, err := d.pg.AddPaymentMethod(ctx, &models.PaymentMethod{
+		CustomerId: customerId,
+		Token:      token,
+		Brand:      card.Brand(),
+		LastFour:   card.LastFour(),
+		ExpMonth:   card.ExpMonth,
+		ExpYear:    card.ExpYear,
+	})
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return method, nil
+}
+
+// GetPaymentMethods returns payment methods of the customer. Customers get only their own
+// methods. Returns AuthError if caller is not authenticated, PermissionError if caller is
+// another customer and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) GetPaymentMethods(ctx context.Context, customerId int) ([]*models.PaymentMethod, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetPaymentMethods")
+	defer span.End()
+
+	if err := validateVar(customerId, "customerId"); err != nil {
+		d.logger(ctx).Debugf("GetPaymentMethods validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, err
+	}
+
+	methods, err := d.pg.GetCustomerPaymentMethods(ctx, customerId)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return methods, nil
+}
+
+// DeletePaymentMethod deletes payment method by given id. Customers delete only their own
+// methods. Returns AuthError if caller is not authenticated, PermissionError if caller is
+// another customer, EntityError if payment method wasn't found and ErrGeneralDBFail if db
+// returned db-specific error
+func (d *dvdstoreUC) DeletePaymentMethod(ctx context.Context, paymentMethodId int) error {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.DeletePaymentMethod")
+	defer span.End()
+
+	if _, err := d.paymentMethod(ctx, paymentMethodId); err != nil {
+		return err
+	}
+
+	err := d.pg.DeletePaymentMethod(ctx, paymentMethodId)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return models.ErrGeneralDBFail
+	}
+	return nil
+}
+
+// paymentMethod returns payment method by given id if the caller may use it. Returns
+// ValidationError if id is not valid, AuthError if caller is not authenticated,
+// PermissionError if method belongs to another customer, EntityError if method wasn't
+// found and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) paymentMethod(ctx context.Context, paymentMethodId int) (*models.PaymentMethod, error) {
+	if err := validateVar(paymentMethodId, "paymentMethodId"); err != nil {
+		d.logger(ctx).Debugf("paymentMethod validate.Var: %v", err)
+		return nil, err
+	}
+
+	method, err := d.pg.GetPaymentMethod(ctx, paymentMethodId)
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+	// Payment method of another customer is not accessible
+	if err := authorizeCustomer(ctx, method.CustomerId); err != nil {
+		var permissionErr *models.PermissionError
+		if errors.As(err, &permissionErr) {
+			return nil, models.ErrPermissionDenied("payment method", paymentMethodId)
+		}
+		return nil, err
+	}
+
+	return method, nil
+}
+
+// authorizePayment authorizes amount on the payment method and returns authorized payment.
+// Returns ValidationError if payment is declined and ErrPaymentFail if gateway failed
+func (d *dvdstoreUC) authorizePayment(ctx context.Context, method *models.PaymentMethod,
+	amount models.Money) (*models.Payment, error) {
+	authorizationId, err := d.gateway.Authorize(ctx, method.Token, amount)
+	if err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrPaymentFail
+	}
+
+	return &models.Payment{
+		AuthorizationId: authorizationId,
+		Amount:          amount,
+		Status:          models.PaymentAuthorized,
+	}, nil
+}
+
+// capturePayment captures authorized payment of the shipped order and marks it captured.
+// Returns ErrPaymentFail if gateway failed. Once the gateway captured the payment the
+// customer is charged, so failure to mark it captured is only logged
+func (d *dvdstoreUC) capturePayment(ctx context.Context, payment *models.Payment) error {
+	if err := d.gateway.Capture(ctx, payment.AuthorizationId); err != nil {
+		d.logger(ctx).Error(err)
+		return models.ErrPaymentFail
+	}
+
+	err := d.pg.UpdatePaymentStatus(ctx, payment.Id, models.PaymentAuthorized, models.PaymentCaptured)
+	if err != nil {
+		d.logger(ctx).Errorf("capturePayment payment %v: %v", payment.Id, err)
+		return nil
+	}
+	payment.Status = models.PaymentCaptured
+	return nil
+}
+
+// voidPayment voids authorized payment of the cancelled order and marks it voided. The order
+// is already cancelled, so failures are only logged and the payment is left authorized until
+// the authorization expires in the gateway
+func (d *dvdstoreUC) voidPayment(ctx context.Context, payment *models.Payment) {
+	if err := d.gateway.Void(ctx, payment.AuthorizationId); err != nil {
+		d.logger(ctx).Errorf("voidPayment payment %v: %v", payment.Id, err)
+		return
+	}
+
+	err := d.pg.UpdatePaymentStatus(ctx, payment.Id, models.PaymentAuthorized, models.PaymentVoided)
+	if err != nil {
+		d.logger(ctx).Errorf("voidPayment payment %v: %v", payment.Id, err)
+		return
+	}
+	payment.Status = models.PaymentVoided
+}
+
+// refundReturn refunds total of the return from the captured payment of the order and marks
+// the refund refunded. The return is already recorded, so failures are only logged and the
+// refund is left pending to be reconciled with the gateway
+func (d *dvdstoreUC) refundReturn(ctx context.Context, payment *models.Payment,
+	ret *models.OrderReturn) {
+	if err := d.gateway.Refund(ctx, payment.AuthorizationId, ret.TotalAmount); err != nil {
+		d.logger(ctx).Errorf("refundReturn return %v: %v", ret.Id, err)
+		return
+	}
+
+	err := d.pg.UpdateReturnRefundStatus(ctx, ret.Id, models.RefundPending, models.RefundRefunded)
+	if err != nil {
+		d.logger(ctx).Errorf("refundReturn return %v: %v", ret.Id, err)
+		return
+	}
+	ret.RefundStatus = models.RefundRefunded
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// ReturnOrderLines records return of provided quantities of the delivered order products and
+// returns them to inventory. Amounts are refunded at order prices and tax rate from the
+// captured payment, the refund is left pending if the order has no captured payment or the
+// gateway failed. The order itself is not changed. Returns recorded return and errors:
+// ValidationError if request is not valid, EntityError if order or product in the order was
+// not found, ConflictError if order is not delivered or returned quantity exceeds not returned
+// quantity of the product and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) ReturnOrderLines(ctx context.Context, orderId int,
+	products []*models.Product) (*models.OrderReturn, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.ReturnOrderLines")
+	defer span.End()
+
+	if len(products) == 0 {
+		return nil, &models.ValidationError{Message: "products must not be empty"}
+	}
+	for _, p := range products {
+		if err := d.validate.StructPartial(p, "Id", "Quantity"); err != nil {
+			d.logger(ctx).Debugf("ReturnOrderLines validate.StructPartial: %v", err)
+			return nil, models.ErrFieldsNotValid("id", "quantity")
+		}
+	}
+
+	order, err := d.GetOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != models.OrderDelivered {
+		return nil, models.ErrReturnNotAllowed("order", orderId, string(order.Status))
+	}
+
+	// Take titles, prices and categories of returned products from the order,
+	// quantities of the same product are summed up
+	ordered := make(map[int]*models.Product, len(order.Products))
+	for _, p := range order.Products {
+		if _, ok := ordered[p.Id]; !ok {
+			ordered[p.Id] = p
+		}
+	}
+	returnedById := make(map[int]*models.Product, len(products))
+	returned := make([]*models.Product, 0, len(products))
+	for _, p := range products {
+		o, ok := ordered[p.Id]
+		if !ok {
+			return nil, &models.EntityError{
+				Entity:  "product",
+				Message: fmt.Sprintf("id %v not found in order %v", p.Id, orderId),
+			}
+		}
+		if r, ok := returnedById[p.Id]; ok {
+			r.Quantity += p.Quantity
+			continue
+		}
+		r := &models.Product{Id: o.Id, Title: o.Title, Price: o.Price, Quantity: p.Quantity,
+			Category: o.Category}
+		returnedById[p.Id], returned = r, append(returned, r)
+	}
+
+	ret, err := d.pg.AddOrderReturn(ctx, d.priceReturn(order, returned), order.Status)
+	if err != nil {
+		var entErr *models.EntityError
+		var conflictErr *models.ConflictError
+		if errors.As(err, &entErr) || errors.As(err, &conflictErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	if order.Payment != nil && order.Payment.Status == models.PaymentCaptured {
+		d.refundReturn(ctx, order.Payment, ret)
+	}
+	return ret, nil
+}
+
+// returnRemaining returns not returned products of the delivered order with ReturnOrderLines
+// and adds the return to the order. Returns the same errors as ReturnOrderLines
+func (d *dvdstoreUC) returnRemaining(ctx context.Context, order *models.Order) error {
+	notReturned := make(map[int]int, len(order.Products))
+	productIds := make([]int, 0, len(order.Products))
+	for _, p := range order.Products {
+		if _, ok := notReturned[p.Id]; !ok {
+			productIds = append(productIds, p.Id)
+		}
+		notReturned[p.Id] += p.Quantity
+	}
+	for _, r := range order.Returns {
+		for _, p := range r.Products {
+			notReturned[p.Id] -= p.Quantity
+		}
+	}
+
+	products := make([]*models.Product, 0, len(productIds))
+	for _, id := range productIds {
+		if q := notReturned[id]; q > 0 {
+			products = append(products, &models.Product{Id: id, Quantity: q})
+		}
+	}
+	if len(products) == 0 {
+		return nil
+	}
+
+	ret, err := d.ReturnOrderLines(ctx, order.Id, products)
+	if err != nil {
+		return err
+	}
+	order.Returns = append(order.Returns, ret)
+	return nil
+}
+
+// priceReturn returns return of products with net amount at order prices and tax at order
+// rate. Amounts are limited by not refunded amounts of the order in the repository, while
+// the order is locked
+func (d *dvdstoreUC) priceReturn(order *models.Order,
+	products []*models.Product) *models.OrderReturn {
+	var net models.Money
+	for _, p := range products {
+		net += p.Price.Mul(p.Quantity)
+	}
+	tax := d.tax.TaxAtRate(order.TaxRate, products)
+
+	return &models.OrderReturn{
+		OrderId:     order.Id,
+		Products:    products,
+		NetAmount:   net,
+		Tax:         tax,
+		TotalAmount: net + tax,
+	}
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import "github.com/alexzh7/sample-service/internal/models"
+
+// orderTransitions defines order statuses that can be reached from the status.
+// Cancelled and refunded orders are final
+var orderTransitions = map[models.OrderStatus][]models.OrderStatus{
+	models.OrderPending:   {models.OrderPaid, models.OrderCancelled},
+	models.OrderPaid:      {models.OrderShipped, models.OrderCancelled},
+	models.OrderShipped:   {models.OrderDelivered},
+	models.OrderDelivered: {models.OrderRefunded},
+}
+
+// canTransition reports whether order can be moved from one status to another
+func canTransition(from, to models.OrderStatus) bool {
+	for _, s := range orderTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
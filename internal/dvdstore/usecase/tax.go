@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// taxCalculator calculates taxes by customer location rates with exemptions for
+// product categories. It implements TaxCalculator interface
+type taxCalculator struct {
+	defaultRate float64
+	rates       []config.TaxRateConfig
+	exempt      map[int]bool
+}
+
+// NewTaxCalculator returns new tax calculator configured by tax config.
+// Returns error if any of the rates is not in [0, 1] range
+func NewTaxCalculator(cfg config.TaxConfig) (*taxCalculator, error) {
+	if !validRate(cfg.DefaultRate) {
+		return nil, fmt.Errorf("NewTaxCalculator: default rate %v must be in [0, 1]", cfg.DefaultRate)
+	}
+	for _, r := range cfg.Rates {
+		if !validRate(r.Rate) {
+			return nil, fmt.Errorf("NewTaxCalculator: rate %+v must be in [0, 1]", r)
+		}
+	}
+
+	exempt := make(map[int]bool, len(cfg.ExemptCategories))
+	for _, c := range cfg.ExemptCategories {
+		exempt[c] = true
+	}
+
+	return &taxCalculator{defaultRate: cfg.DefaultRate, rates: cfg.Rates, exempt: exempt}, nil
+}
+
+// Tax returns tax rate for customer location and tax amount for ordered products.
+// Tax is calculated for the sum of taxable lines and rounded to cents half away
+// from zero. Products of exempt categories are not taxed
+func (t *taxCalculator) Tax(customer *models.Customer, products []*models.Product) (rate float64, tax models.Money) {
+	rate = t.rate(customer)
+	return rate, t.TaxAtRate(rate, products)
+}
+
+// TaxAtRate returns tax amount for products at passed rate. Tax is calculated
+// the same way as by Tax
+func (t *taxCalculator) TaxAtRate(rate float64, products []*models.Product) models.Money {
+	var taxable models.Money
+	for _, p := range products {
+		if t.exempt[p.Category] {
+			continue
+		}
+		taxable += p.Price.Mul(p.Quantity)
+	}
+	return taxable.MulRate(rate)
+}
+
+// rate returns the most specific rate matching customer location or default rate
+func (t *taxCalculator) rate(customer *models.Customer) float64 {
+	rate, best := t.defaultRate, 0
+	for _, r := range t.rates {
+		if (r.Region != 0 && r.Region != customer.Region) ||
+			(r.Country != "" && r.Country != customer.Country) ||
+			(r.State != "" && r.State != customer.State) {
+			continue
+		}
+
+		// State is more specific than country and country is more specific than region
+		var specificity int
+		if r.State != "" {
+			specificity += 4
+		}
+		if r.Country != "" {
+			specificity += 2
+		}
+		if r.Region != 0 {
+			specificity += 1
+		}
+		if specificity > best {
+			rate, best = r.Rate, specificity
+		}
+	}
+	return rate
+}
+
+// validRate checks that rate is in [0, 1] range
+func validRate(rate float64) bool {
+	return rate >= 0 && rate <= 1
+}
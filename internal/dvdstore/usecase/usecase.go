@@ -1,19 +1,37 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/logging"
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 )
 
+// tracer traces use case methods
+var tracer = otel.Tracer("github.com/alexzh7/sample-service/internal/dvdstore/usecase")
+
 // dvdstoreUC is a use case for dvdstore. It implements Usecase interface
 type dvdstoreUC struct {
-	pg       dvdstore.PostgresRepo
-	log      *zap.SugaredLogger
-	validate *models.Validation
+	pg        dvdstore.PostgresRepo
+	log       *zap.SugaredLogger
+	validate  *models.Validation
+	tax       dvdstore.TaxCalculator
+	tokens    dvdstore.TokenManager
+	tokenizer dvdstore.CardTokenizer
+	gateway   dvdstore.PaymentGateway
+	// idempotencyRetention is how long AddOrder idempotency keys are kept
+	idempotencyRetention time.Duration
+	// cartExpiry is how long carts are kept without changes
+	cartExpiry time.Duration
 }
 
 // NewDvdstoreUC returns new dvd store use case
@@ -21,112 +39,314 @@ func NewDvdstoreUC(
 	pg dvdstore.PostgresRepo,
 	log *zap.SugaredLogger,
 	vl *models.Validation,
+	tax dvdstore.TaxCalculator,
+	tokens dvdstore.TokenManager,
+	tokenizer dvdstore.CardTokenizer,
+	gateway dvdstore.PaymentGateway,
+	idempotencyRetention time.Duration,
+	cartExpiry time.Duration,
 ) *dvdstoreUC {
-	return &dvdstoreUC{pg: pg, log: log, validate: vl}
+	return &dvdstoreUC{pg: pg, log: log, validate: vl, tax: tax, tokens: tokens, tokenizer: tokenizer,
+		gateway: gateway, idempotencyRetention: idempotencyRetention, cartExpiry: cartExpiry}
 }
 
-// GetCustomers returns list of all customers limited by limit and ErrGeneralDBFail
-// if db returned db-specific error. Limit must be > 0
-func (d *dvdstoreUC) GetCustomers(limit int) ([]*models.Customer, error) {
-	if err := validateVar(limit, "limit"); err != nil {
-		d.log.Debugf("GetCustomers validate.Var: %v", err)
-		return nil, err
+// logger returns request logger from context or use case logger if there is none
+func (d *dvdstoreUC) logger(ctx context.Context) *zap.SugaredLogger {
+	return logging.FromContext(ctx, d.log)
+}
+
+// GetCustomers returns requested page of customers and token of the next page. Token is
+// empty if there are no more customers. Returns ValidationError if page request is not valid
+// and ErrGeneralDBFail if db returned db-specific error. Limit must be > 0
+func (d *dvdstoreUC) GetCustomers(ctx context.Context, page models.PageRequest) (customers []*models.Customer,
+	nextPageToken string, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetCustomers")
+	defer span.End()
+
+	sortBy, after, err := pageCursor(page, models.CustomerSortFields)
+	if err != nil {
+		d.logger(ctx).Debugf("GetCustomers pageCursor: %v", err)
+		return nil, "", err
 	}
 
-	customers, err := d.pg.GetAllCustomers(limit)
+	// Request one more customer to find out if there is the next page
+	customers, err = d.pg.GetAllCustomers(ctx, page.Limit+1, sortBy, after)
 	if err != nil {
-		d.log.Error(err)
-		return nil, models.ErrGeneralDBFail
+		d.logger(ctx).Error(err)
+		return nil, "", models.ErrGeneralDBFail
+	}
+
+	if len(customers) > page.Limit {
+		customers = customers[:page.Limit]
+		last := customers[page.Limit-1]
+		nextPageToken = pageToken(sortBy, last.SortValue(sortBy), last.Id)
 	}
 
-	return customers, nil
+	return customers, nextPageToken, nil
 }
 
-// GetCustomer returns customer by given id, EntityError if customer wasn't found
-// and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) GetCustomer(customerId int) (*models.Customer, error) {
+// GetCustomer returns customer by given id. Customers get only themselves. Returns AuthError
+// if caller is not authenticated, PermissionError if caller is another customer, EntityError
+// if customer wasn't found and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) GetCustomer(ctx context.Context, customerId int) (*models.Customer, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetCustomer")
+	defer span.End()
+
 	if err := validateVar(customerId, "customerId"); err != nil {
-		d.log.Debugf("GetCustomer validate.Var: %v", err)
+		d.logger(ctx).Debugf("GetCustomer validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
 		return nil, err
 	}
 
-	customer, err := d.pg.GetCustomer(customerId)
+	customer, err := d.pg.GetCustomer(ctx, customerId)
 	if err != nil {
 		if _, ok := err.(*models.EntityError); ok {
 			return nil, err
 		}
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return nil, models.ErrGeneralDBFail
 	}
 
 	return customer, nil
 }
 
-// AddCustomer adds a customer returning id and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) AddCustomer(customer *models.Customer) (id int, err error) {
-	err = d.validate.StructPartial(customer, "FirstName", "LastName", "Age")
+// AddCustomer registers a customer with username, password and profile returning id. Password is
+// stored as bcrypt hash, region is derived from country. Returns ValidationError if customer or
+// password is not valid, ConflictError if username is taken, ErrInternal if password wasn't
+// hashed and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AddCustomer(ctx context.Context, customer *models.Customer, password string) (id int,
+	err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddCustomer")
+	defer span.End()
+
+	fields := append([]string{"Username"}, models.CustomerUpdatableFields...)
+	if err = d.validate.StructPartial(customer, fields...); err != nil {
+		d.logger(ctx).Debugf("AddCustomer validate.StructPartial: %v", err)
+		return 0, models.ErrFieldsNotValid(invalidFields(err, fields)...)
+	}
+	if err = customer.ValidateAddress(); err != nil {
+		return 0, err
+	}
+	customer.Region = models.RegionOf(customer.Country)
+	err = d.validate.StructPartial(&models.Credentials{Password: password}, "Password")
+	if err != nil {
+		d.logger(ctx).Debugf("AddCustomer validate.StructPartial: %v", err)
+		return 0, &models.ValidationError{Message: "password must be 8 to 72 characters long"}
+	}
+
+	hash, err := hashPassword(password)
 	if err != nil {
-		d.log.Debugf("AddCustomer validate.StructPartial: %v", err)
-		return 0, models.ErrFieldsNotValid("firstname", "lastname", "age")
+		d.logger(ctx).Error(err)
+		return 0, models.ErrInternal
 	}
 
-	id, err = d.pg.AddCustomer(customer)
+	id, err = d.pg.AddCustomer(ctx, customer, hash)
 	if err != nil {
-		d.log.Error(err)
+		if _, ok := err.(*models.ConflictError); ok {
+			return 0, err
+		}
+		d.logger(ctx).Error(err)
 		return 0, models.ErrGeneralDBFail
 	}
 
 	return id, nil
 }
 
+// UpdateCustomer updates listed fields of customer and returns updated customer. Updates first
+// name, last name and age if fields are empty. Updated address must stay consistent with the
+// rest of stored address, region follows country. Customers update only themselves. Returns
+// ValidationError if fields are not valid, AuthError if caller is not authenticated,
+// PermissionError if caller is another customer, EntityError if customer wasn't found and
+// ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) UpdateCustomer(ctx context.Context, customer *models.Customer,
+	fields []string) (*models.Customer, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.UpdateCustomer")
+	defer span.End()
+
+	if err := validateVar(customer.Id, "customerId"); err != nil {
+		d.logger(ctx).Debugf("UpdateCustomer validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customer.Id); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		fields = models.CustomerDefaultUpdateFields
+	}
+	fields, err := updateFields(fields, models.CustomerUpdatableFields)
+	if err != nil {
+		d.logger(ctx).Debugf("UpdateCustomer updateFields: %v", err)
+		return nil, err
+	}
+	if err := d.validate.StructPartial(customer, fields...); err != nil {
+		d.logger(ctx).Debugf("UpdateCustomer validate.StructPartial: %v", err)
+		return nil, models.ErrFieldsNotValid(invalidFields(err, fields)...)
+	}
+	if fields, err = d.updateAddress(ctx, customer, fields); err != nil {
+		return nil, err
+	}
+
+	updated, err := d.pg.UpdateCustomer(ctx, customer, fields)
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return updated, nil
+}
+
+// updateAddress validates address of customer with address fields from update merged into the
+// stored customer. Region is added to updated fields if country is updated. Returns fields to
+// update, ValidationError if merged address is not consistent, EntityError if customer wasn't
+// found and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) updateAddress(ctx context.Context, customer *models.Customer,
+	fields []string) ([]string, error) {
+	updatesAddress := false
+	for _, f := range models.CustomerAddressFields {
+		updatesAddress = updatesAddress || contains(fields, f)
+	}
+	if !updatesAddress {
+		return fields, nil
+	}
+
+	stored, err := d.pg.GetCustomer(ctx, customer.Id)
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+	merged := &models.Customer{State: stored.State, Zip: stored.Zip, Country: stored.Country}
+	if contains(fields, "State") {
+		merged.State = customer.State
+	}
+	if contains(fields, "Zip") {
+		merged.Zip = customer.Zip
+	}
+	if contains(fields, "Country") {
+		merged.Country = customer.Country
+	}
+	if err := merged.ValidateAddress(); err != nil {
+		return nil, err
+	}
+
+	if contains(fields, "Country") {
+		customer.Region = models.RegionOf(customer.Country)
+		fields = append(fields[:len(fields):len(fields)], "Region")
+	}
+	return fields, nil
+}
+
 // DeleteCustomer deletes customer with provided id and ErrGeneralDBFail if db returned
 // db-specific error
-func (d *dvdstoreUC) DeleteCustomer(customerId int) error {
+func (d *dvdstoreUC) DeleteCustomer(ctx context.Context, customerId int) error {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.DeleteCustomer")
+	defer span.End()
+
 	if err := validateVar(customerId, "customerId"); err != nil {
-		d.log.Debugf("DeleteCustomer validate.Var: %v", err)
+		d.logger(ctx).Debugf("DeleteCustomer validate.Var: %v", err)
 		return err
 	}
 
-	err := d.pg.DeleteCustomer(customerId)
+	err := d.pg.DeleteCustomer(ctx, customerId)
 	if err != nil {
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return models.ErrGeneralDBFail
 	}
 
 	return nil
 }
 
-// GetProducts returns slice of all products limited by limit and ErrGeneralDBFail if db
-// returned db-specific error
-func (d *dvdstoreUC) GetProducts(limit int) ([]*models.Product, error) {
-	if err := validateVar(limit, "limit"); err != nil {
-		d.log.Debugf("GetProducts validate.Var: %v", err)
-		return nil, err
+// GetProducts returns requested page of products and token of the next page. Token is
+// empty if there are no more products. Returns ValidationError if page request is not valid
+// and ErrGeneralDBFail if db returned db-specific error. Limit must be > 0
+func (d *dvdstoreUC) GetProducts(ctx context.Context, page models.PageRequest) (products []*models.Product,
+	nextPageToken string, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetProducts")
+	defer span.End()
+
+	sortBy, after, err := pageCursor(page, models.ProductSortFields)
+	if err != nil {
+		d.logger(ctx).Debugf("GetProducts pageCursor: %v", err)
+		return nil, "", err
 	}
 
-	products, err := d.pg.GetAllProducts(limit)
+	// Request one more product to find out if there is the next page
+	products, err = d.pg.GetAllProducts(ctx, page.Limit+1, sortBy, after)
 	if err != nil {
-		d.log.Error(err)
-		return nil, models.ErrGeneralDBFail
+		d.logger(ctx).Error(err)
+		return nil, "", models.ErrGeneralDBFail
+	}
+
+	if len(products) > page.Limit {
+		products = products[:page.Limit]
+		last := products[page.Limit-1]
+		nextPageToken = pageToken(sortBy, last.SortValue(sortBy), last.Id)
+	}
+
+	return products, nextPageToken, nil
+}
+
+// SearchProducts returns requested page of products matching the filter and token of the
+// next page. Token is empty if there are no more products. Returns ValidationError if filter
+// or page request is not valid and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) SearchProducts(ctx context.Context, filter *models.ProductFilter,
+	page models.PageRequest) (products []*models.Product, nextPageToken string, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.SearchProducts")
+	defer span.End()
+
+	if err := d.validate.Struct(filter); err != nil {
+		d.logger(ctx).Debugf("SearchProducts validate.Struct: %v", err)
+		return nil, "", models.ErrFieldsNotValid("query", "categories", "minPrice", "maxPrice")
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, "", &models.ValidationError{Message: "minPrice must be <= maxPrice"}
+	}
+	sortBy, after, err := pageCursor(page, models.ProductSortFields)
+	if err != nil {
+		d.logger(ctx).Debugf("SearchProducts pageCursor: %v", err)
+		return nil, "", err
 	}
 
-	return products, nil
+	// Request one more product to find out if there is the next page
+	products, err = d.pg.SearchProducts(ctx, filter, page.Limit+1, sortBy, after)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return nil, "", models.ErrGeneralDBFail
+	}
+
+	if len(products) > page.Limit {
+		products = products[:page.Limit]
+		last := products[page.Limit-1]
+		nextPageToken = pageToken(sortBy, last.SortValue(sortBy), last.Id)
+	}
+
+	return products, nextPageToken, nil
 }
 
 // GetProduct returns product by given id, EntityError if product wasn't found
 // and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) GetProduct(productId int) (*models.Product, error) {
+func (d *dvdstoreUC) GetProduct(ctx context.Context, productId int) (*models.Product, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetProduct")
+	defer span.End()
+
 	if err := validateVar(productId, "productId"); err != nil {
-		d.log.Debugf("GetProduct validate.Var: %v", err)
+		d.logger(ctx).Debugf("GetProduct validate.Var: %v", err)
 		return nil, err
 	}
 
-	product, err := d.pg.GetProduct(productId)
+	product, err := d.pg.GetProduct(ctx, productId)
 	if err != nil {
 		if _, ok := err.(*models.EntityError); ok {
 			return nil, err
 		}
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return nil, models.ErrGeneralDBFail
 	}
 
@@ -134,97 +354,278 @@ func (d *dvdstoreUC) GetProduct(productId int) (*models.Product, error) {
 }
 
 // AddProduct adds a product returning id and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) AddProduct(prod *models.Product) (productId int, err error) {
-	err = d.validate.StructPartial(prod, "Title", "Price", "Quantity")
+func (d *dvdstoreUC) AddProduct(ctx context.Context, prod *models.Product) (productId int, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddProduct")
+	defer span.End()
+
+	err = d.validate.StructPartial(prod, "Title", "Price", "Quantity", "Category")
 	if err != nil {
-		d.log.Debugf("AddProduct validate.StructPartial: %v", err)
-		return 0, models.ErrFieldsNotValid("title", "price", "quantity")
+		d.logger(ctx).Debugf("AddProduct validate.StructPartial: %v", err)
+		return 0, models.ErrFieldsNotValid("title", "price", "quantity", "category")
+	}
+	if err = d.checkCategory(ctx, prod.Category); err != nil {
+		return 0, err
 	}
 
-	productId, err = d.pg.AddProduct(prod)
+	productId, err = d.pg.AddProduct(ctx, prod)
 	if err != nil {
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return 0, models.ErrGeneralDBFail
 	}
 
 	return productId, nil
 }
 
+// UpdateProduct updates listed fields of product and returns updated product. Updates all
+// updatable fields if fields are empty. Returns ValidationError if fields are not valid,
+// EntityError if product wasn't found and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) UpdateProduct(ctx context.Context, prod *models.Product,
+	fields []string) (*models.Product, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.UpdateProduct")
+	defer span.End()
+
+	if err := validateVar(prod.Id, "productId"); err != nil {
+		d.logger(ctx).Debugf("UpdateProduct validate.Var: %v", err)
+		return nil, err
+	}
+	fields, err := updateFields(fields, models.ProductUpdatableFields)
+	if err != nil {
+		d.logger(ctx).Debugf("UpdateProduct updateFields: %v", err)
+		return nil, err
+	}
+	if err := d.validate.StructPartial(prod, fields...); err != nil {
+		d.logger(ctx).Debugf("UpdateProduct validate.StructPartial: %v", err)
+		return nil, models.ErrFieldsNotValid(lowerAll(fields)...)
+	}
+	if contains(fields, "Category") {
+		if err := d.checkCategory(ctx, prod.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := d.pg.UpdateProduct(ctx, prod, fields)
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return updated, nil
+}
+
+// checkCategory is a helper function that returns EntityError if category doesn't exist
+// and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) checkCategory(ctx context.Context, categoryId int) error {
+	if _, err := d.pg.GetCategory(ctx, categoryId); err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return err
+		}
+		d.logger(ctx).Error(err)
+		return models.ErrGeneralDBFail
+	}
+	return nil
+}
+
+// AdjustInventory changes product quantity in stock by delta and returns updated product.
+// Returns EntityError if product wasn't found or quantity would become negative
+// and ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AdjustInventory(ctx context.Context, productId int, delta int) (*models.Product, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AdjustInventory")
+	defer span.End()
+
+	if err := validateVar(productId, "productId"); err != nil {
+		d.logger(ctx).Debugf("AdjustInventory validate.Var: %v", err)
+		return nil, err
+	}
+	if delta == 0 || delta > math.MaxInt32 || delta < math.MinInt32 {
+		return nil, models.ErrFieldsNotValid("delta")
+	}
+
+	product, err := d.pg.AdjustInventory(ctx, productId, delta)
+	if err != nil {
+		if _, ok := err.(*models.EntityError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return product, nil
+}
+
 // DeleteProduct deletes product with provided id and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) DeleteProduct(productId int) error {
+func (d *dvdstoreUC) DeleteProduct(ctx context.Context, productId int) error {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.DeleteProduct")
+	defer span.End()
+
 	if err := validateVar(productId, "productId"); err != nil {
-		d.log.Debugf("DeleteProduct validate.Var: %v", err)
+		d.logger(ctx).Debugf("DeleteProduct validate.Var: %v", err)
 		return err
 	}
 
-	err := d.pg.DeleteProduct(productId)
+	err := d.pg.DeleteProduct(ctx, productId)
 	if err != nil {
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return models.ErrGeneralDBFail
 	}
 
 	return nil
 }
 
-// GetOrder gets order by order id. Returns EntityError if order was not found
+// ListCategories returns all categories sorted by id and ErrGeneralDBFail
+// if db returned db-specific error
+func (d *dvdstoreUC) ListCategories(ctx context.Context) ([]*models.Category, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.ListCategories")
+	defer span.End()
+
+	categories, err := d.pg.GetAllCategories(ctx)
+	if err != nil {
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return categories, nil
+}
+
+// AddCategory adds a category returning id. Returns ValidationError if category name
+// is not valid, ConflictError if category with the same name already exists and
+// ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AddCategory(ctx context.Context, category *models.Category) (categoryId int, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddCategory")
+	defer span.End()
+
+	if err = d.validate.StructPartial(category, "Name"); err != nil {
+		d.logger(ctx).Debugf("AddCategory validate.StructPartial: %v", err)
+		return 0, models.ErrFieldsNotValid("name")
+	}
+
+	categoryId, err = d.pg.AddCategory(ctx, category)
+	if err != nil {
+		if _, ok := err.(*models.ConflictError); ok {
+			return 0, err
+		}
+		d.logger(ctx).Error(err)
+		return 0, models.ErrGeneralDBFail
+	}
+
+	return categoryId, nil
+}
+
+// GetOrder gets order with its returns by order id. Returns EntityError if order was not found
 // and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) GetOrder(orderId int) (*models.Order, error) {
+func (d *dvdstoreUC) GetOrder(ctx context.Context, orderId int) (*models.Order, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetOrder")
+	defer span.End()
+
 	if err := validateVar(orderId, "orderId"); err != nil {
-		d.log.Debugf("GetOrder validate.Var: %v", err)
+		d.logger(ctx).Debugf("GetOrder validate.Var: %v", err)
 		return nil, err
 	}
 
-	order, err := d.pg.GetOrder(orderId)
+	order, err := d.pg.GetOrder(ctx, orderId)
 	if err != nil {
 		if _, ok := err.(*models.EntityError); ok {
 			return nil, err
 		}
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	order.Returns, err = d.pg.GetOrderReturns(ctx, orderId)
+	if err != nil {
+		d.logger(ctx).Error(err)
 		return nil, models.ErrGeneralDBFail
 	}
 
 	return order, nil
 }
 
-// GetCustomerOrders gets orders for provided customer id. Returns EntityError if
+// GetCustomerOrders gets requested page of orders for provided customer id filtered by statuses
+// and token of the next page. Orders in all statuses are returned if statuses are empty, page
+// limit is 100 if it is not set. Token is empty if there are no more orders. Customers get only
+// their own orders. Returns ValidationError if request is not valid, AuthError if
+// caller is not authenticated, PermissionError if caller is another customer, EntityError if
 // order or customer was not found and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) GetCustomerOrders(customerId int) ([]*models.Order, error) {
+func (d *dvdstoreUC) GetCustomerOrders(ctx context.Context, customerId int, statuses []models.OrderStatus,
+	page models.PageRequest) (orders []*models.Order, nextPageToken string, err error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.GetCustomerOrders")
+	defer span.End()
+
 	if err := validateVar(customerId, "customerId"); err != nil {
-		d.log.Debugf("GetCustomerOrders validate.Var: %v", err)
-		return nil, err
+		d.logger(ctx).Debugf("GetCustomerOrders validate.Var: %v", err)
+		return nil, "", err
+	}
+	// Customers read only their own orders
+	if err := authorizeCustomer(ctx, customerId); err != nil {
+		return nil, "", err
+	}
+	for _, s := range statuses {
+		if !s.Valid() {
+			return nil, "", models.ErrFieldsNotValid("statuses")
+		}
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultOrdersLimit
+	}
+	sortBy, after, err := pageCursor(page, models.OrderSortFields)
+	if err != nil {
+		d.logger(ctx).Debugf("GetCustomerOrders pageCursor: %v", err)
+		return nil, "", err
 	}
 
 	// Check if customer exists
 	var entErr *models.EntityError
-	_, err := d.GetCustomer(customerId)
+	_, err = d.GetCustomer(ctx, customerId)
 	if err != nil {
 		if errors.As(err, &entErr) {
-			return nil, err
+			return nil, "", err
 		}
-		d.log.Error(err)
-		return nil, models.ErrGeneralDBFail
+		d.logger(ctx).Error(err)
+		return nil, "", models.ErrGeneralDBFail
 	}
 
-	// Get orders
-	orders, err := d.pg.GetCustomerOrders(customerId)
+	// Get orders, request one more order to find out if there is the next page
+	orders, err = d.pg.GetCustomerOrders(ctx, customerId, statuses, page.Limit+1, sortBy, after)
 	if err != nil {
 		if errors.As(err, &entErr) {
-			return nil, err
+			return nil, "", err
 		}
-		d.log.Error(err)
-		return nil, models.ErrGeneralDBFail
+		d.logger(ctx).Error(err)
+		return nil, "", models.ErrGeneralDBFail
 	}
 
-	return orders, nil
+	if len(orders) > page.Limit {
+		orders = orders[:page.Limit]
+		last := orders[page.Limit-1]
+		nextPageToken = pageToken(sortBy, last.SortValue(sortBy), last.Id)
+	}
+
+	return orders, nextPageToken, nil
 }
 
-// AddOrder creates order for customerId with provided products. Returns order and errors:
-// EntityError if product/customer was not found or product is out of inventory
-// and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) AddOrder(customerId int, products []*models.Product) (*models.Order, error) {
+// AddOrder creates order for customerId with provided products paid with the customer payment
+// method. Order total is authorized on the payment method before the order is created and the
+// authorization is voided if the order wasn't created. Customers order only for themselves. If
+// idempotency key is not empty and was already used with the same request, the order created by
+// that request is returned. Returns order and errors: ValidationError if payment method is expired
+// or payment is declined, AuthError if caller is not authenticated, PermissionError if caller is
+// another customer or payment method belongs to another customer, EntityError if
+// product/customer/payment method was not found or product is out of inventory, ConflictError if
+// idempotency key was used with another request, ErrPaymentFail if payment gateway failed and
+// ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) AddOrder(ctx context.Context, customerId int, products []*models.Product,
+	paymentMethodId int, idempotencyKey string) (*models.Order, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.AddOrder")
+	defer span.End()
+
 	// Validate inputs
 	if err := validateVar(customerId, "customerId"); err != nil {
-		d.log.Debugf("AddOrder validate.Var: %v", err)
+		d.logger(ctx).Debugf("AddOrder validate.Var: %v", err)
+		return nil, err
+	}
+	if err := authorizeCustomer(ctx, customerId); err != nil {
 		return nil, err
 	}
 	if len(products) == 0 {
@@ -232,45 +633,253 @@ func (d *dvdstoreUC) AddOrder(customerId int, products []*models.Product) (*mode
 	}
 	for _, p := range products {
 		if err := d.validate.StructPartial(p, "Id", "Quantity"); err != nil {
-			d.log.Debugf("AddOrder validate.StructPartial: %v", err)
+			d.logger(ctx).Debugf("AddOrder validate.StructPartial: %v", err)
 			return nil, models.ErrFieldsNotValid("id", "quantity")
 		}
 	}
+	if err := validateIdempotencyKey(idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	// Return the order of the replayed request
+	var key *models.IdempotencyKey
+	if idempotencyKey != "" {
+		key = &models.IdempotencyKey{
+			Key:         idempotencyKey,
+			RequestHash: orderRequestHash(customerId, products, paymentMethodId),
+			ExpiresAt:   time.Now().Add(d.idempotencyRetention),
+		}
+		if order, err := d.replayOrder(ctx, key); order != nil || err != nil {
+			return order, err
+		}
+	}
+
+	return d.placeOrder(ctx, customerId, products, paymentMethodId, key)
+}
 
+// placeOrder prices and creates order of validated products for customerId paid with the
+// payment method, authorizing order total before the order is created. Idempotency key is
+// saved with the order if it's not nil. Returns the same errors as AddOrder
+func (d *dvdstoreUC) placeOrder(ctx context.Context, customerId int, products []*models.Product,
+	paymentMethodId int, key *models.IdempotencyKey) (*models.Order, error) {
 	// Check if customer exists
-	_, err := d.GetCustomer(customerId)
+	customer, err := d.GetCustomer(ctx, customerId)
 	var entErr *models.EntityError
 	if err != nil {
 		if errors.As(err, &entErr) {
 			return nil, err
 		}
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	// Check that payment method is the customer's and not expired
+	method, err := d.paymentMethod(ctx, paymentMethodId)
+	if err != nil {
+		return nil, err
+	}
+	if method.CustomerId != customerId {
+		return nil, models.ErrPermissionDenied("payment method", paymentMethodId)
+	}
+	if method.Expired(time.Now()) {
+		return nil, &models.ValidationError{Message: "payment method is expired"}
+	}
+
+	// Price the order
+	order, err := d.priceOrder(ctx, customer, products)
+	if err != nil {
+		if errors.As(err, &entErr) {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
 		return nil, models.ErrGeneralDBFail
 	}
+	order.PaymentMethodId = method.Id
+
+	// Authorize order total before the order is created
+	order.Payment, err = d.authorizePayment(ctx, method, order.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add order
-	order, err := d.pg.AddOrder(customerId, products)
+	authorizationId := order.Payment.AuthorizationId
+	order, err = d.pg.AddOrder(ctx, customerId, order, key)
 	if err != nil {
+		// Order wasn't created, release the authorization
+		if voidErr := d.gateway.Void(ctx, authorizationId); voidErr != nil {
+			d.logger(ctx).Error(voidErr)
+		}
 		if errors.As(err, &entErr) {
 			return nil, err
 		}
-		d.log.Error(err)
+		// Concurrent request with the same key created the order first
+		var conflictErr *models.ConflictError
+		if key != nil && errors.As(err, &conflictErr) {
+			if order, err := d.replayOrder(ctx, key); order != nil || err != nil {
+				return order, err
+			}
+		}
+		d.logger(ctx).Error(err)
+		return nil, models.ErrGeneralDBFail
+	}
+
+	return order, nil
+}
+
+// priceOrder fills products with their current titles, prices and categories and returns
+// order with net amount, tax and total amount. Returns EntityError if some of the products
+// were not found
+func (d *dvdstoreUC) priceOrder(ctx context.Context, customer *models.Customer,
+	products []*models.Product) (*models.Order, error) {
+	productIds := make([]int, 0, len(products))
+	for _, p := range products {
+		productIds = append(productIds, p.Id)
+	}
+
+	found, err := d.pg.GetProductsByIds(ctx, productIds)
+	if err != nil {
+		return nil, err
+	}
+	foundById := make(map[int]*models.Product, len(found))
+	for _, f := range found {
+		foundById[f.Id] = f
+	}
+	if len(foundById) != len(products) {
+		return nil, &models.EntityError{
+			Message: "some of the provided products not found",
+		}
+	}
+
+	var net models.Money
+	for _, p := range products {
+		f := foundById[p.Id]
+		p.Title, p.Price, p.Category = f.Title, f.Price, f.Category
+		net += p.Price.Mul(p.Quantity)
+	}
+	rate, tax := d.tax.Tax(customer, products)
+
+	return &models.Order{
+		NetAmount:   net,
+		Tax:         tax,
+		TaxRate:     rate,
+		TotalAmount: net + tax,
+		Products:    products,
+	}, nil
+}
+
+// CancelOrder cancels order by given order id, returns ordered products to inventory and
+// voids authorized payment. Returns EntityError if order was not found, ConflictError if
+// order is already cancelled or can't be cancelled in its status and ErrGeneralDBFail if db
+// returned db-specific error
+func (d *dvdstoreUC) CancelOrder(ctx context.Context, orderId int) error {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.CancelOrder")
+	defer span.End()
+
+	order, err := d.GetOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	if order.Status == models.OrderCancelled {
+		return models.ErrAlreadyCancelled("order", orderId)
+	}
+	if !canTransition(order.Status, models.OrderCancelled) {
+		return models.ErrInvalidTransition("order", orderId, string(order.Status),
+			string(models.OrderCancelled))
+	}
+
+	err = d.pg.CancelOrder(ctx, orderId, order.Status)
+	if err != nil {
+		if _, ok := err.(*models.ConflictError); ok {
+			return err
+		}
+		d.logger(ctx).Error(err)
+		return models.ErrGeneralDBFail
+	}
+
+	if order.Payment != nil && order.Payment.Status == models.PaymentAuthorized {
+		d.voidPayment(ctx, order.Payment)
+	}
+	return nil
+}
+
+// TransitionOrder moves order with given order id to provided status and returns the order.
+// Moving to cancelled status cancels order with CancelOrder, moving to refunded status returns
+// not returned products with ReturnOrderLines. Authorized payment is captured after the order
+// is shipped, the order is moved back to its previous status if payment wasn't captured.
+// Returns ValidationError if status is not valid, EntityError if order was not found,
+// ConflictError if transition is not allowed, ErrPaymentFail if payment wasn't captured and
+// ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) TransitionOrder(ctx context.Context, orderId int,
+	status models.OrderStatus) (*models.Order, error) {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.TransitionOrder")
+	defer span.End()
+
+	if !status.Valid() {
+		return nil, models.ErrFieldsNotValid("status")
+	}
+
+	if status == models.OrderCancelled {
+		if err := d.CancelOrder(ctx, orderId); err != nil {
+			return nil, err
+		}
+		return d.GetOrder(ctx, orderId)
+	}
+
+	order, err := d.GetOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransition(order.Status, status) {
+		return nil, models.ErrInvalidTransition("order", orderId, string(order.Status), string(status))
+	}
+
+	// Refunded order gives back all its products, so they are restocked and refunded
+	if status == models.OrderRefunded {
+		if err := d.returnRemaining(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	err = d.pg.UpdateOrderStatus(ctx, orderId, order.Status, status)
+	if err != nil {
+		if _, ok := err.(*models.ConflictError); ok {
+			return nil, err
+		}
+		d.logger(ctx).Error(err)
 		return nil, models.ErrGeneralDBFail
 	}
 
+	// Shipped order is charged. Status is changed first, so order cancelled concurrently
+	// is never charged
+	if status == models.OrderShipped && order.Payment != nil && order.Payment.Status == models.PaymentAuthorized {
+		if err := d.capturePayment(ctx, order.Payment); err != nil {
+			if revertErr := d.pg.UpdateOrderStatus(ctx, orderId, status, order.Status); revertErr != nil {
+				d.logger(ctx).Errorf("TransitionOrder order %v: %v", orderId, revertErr)
+			}
+			return nil, err
+		}
+	}
+	order.Status = status
+
 	return order, nil
 }
 
-// DeleteOrder deletes order by given order id and ErrGeneralDBFail if db returned db-specific error
-func (d *dvdstoreUC) DeleteOrder(orderId int) error {
+// DeleteOrder hard-deletes order by given order id without returning products to inventory.
+// It is reserved for admins, orders are cancelled with CancelOrder.
+// Returns ErrGeneralDBFail if db returned db-specific error
+func (d *dvdstoreUC) DeleteOrder(ctx context.Context, orderId int) error {
+	ctx, span := tracer.Start(ctx, "dvdstoreUC.DeleteOrder")
+	defer span.End()
+
 	if err := validateVar(orderId, "orderId"); err != nil {
-		d.log.Debugf("DeleteOrder validate.Var: %v", err)
+		d.logger(ctx).Debugf("DeleteOrder validate.Var: %v", err)
 		return err
 	}
 
-	err := d.pg.DeleteOrder(orderId)
+	err := d.pg.DeleteOrder(ctx, orderId)
 	if err != nil {
-		d.log.Error(err)
+		d.logger(ctx).Error(err)
 		return models.ErrGeneralDBFail
 	}
 	return nil
@@ -287,3 +896,54 @@ func validateVar(variable int, varName string) error {
 		Message: fmt.Sprintf("%v must be > 0", varName),
 	}
 }
+
+// updateFields is a helper function that checks that all fields are allowed to be updated
+// and returns ValidationError if not. Returns all allowed fields if fields are empty
+func updateFields(fields []string, allowed []string) ([]string, error) {
+	if len(fields) == 0 {
+		return allowed, nil
+	}
+
+	for _, f := range fields {
+		if !contains(allowed, f) {
+			return nil, &models.ValidationError{
+				Message: fmt.Sprintf("field %q can not be updated, allowed fields: %q", f, allowed),
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// invalidFields is a helper function that returns lowercased names of fields that failed
+// validation. Returns all fields if err is not validation errors
+func invalidFields(err error, fields []string) []string {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return lowerAll(fields)
+	}
+	invalid := make([]string, 0, len(errs))
+	for _, e := range errs {
+		invalid = append(invalid, strings.ToLower(e.StructField()))
+	}
+	return invalid
+}
+
+// lowerAll is a helper function that returns lowercased copy of strings
+func lowerAll(strs []string) []string {
+	lowered := make([]string, 0, len(strs))
+	for _, s := range strs {
+		lowered = append(lowered, strings.ToLower(s))
+	}
+	return lowered
+}
+
+// contains is a helper function that reports whether strs contain str
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -1,14 +1,24 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"math"
 	"testing"
+	"time"
 
+	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/dvdstore"
+	"github.com/alexzh7/sample-service/internal/dvdstore/repository/memory"
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/internal/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // TODO
-// Implement dvdstore.PostgresRepo
 // Check ALL validations
 
 func TestAddProduct(t *testing.T) {
@@ -18,7 +28,7 @@ func TestAddProduct(t *testing.T) {
 	product := &models.Product{
 		Id:       1,
 		Title:    "32",
-		Price:    math.Inf(1),
+		Price:    -1,
 		Quantity: 272237289213213,
 	}
 
@@ -26,3 +36,778 @@ func TestAddProduct(t *testing.T) {
 
 	t.Logf("\n\n ERR: %v \n\n", err)
 }
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to models.OrderStatus
+		want     bool
+	}{
+		{models.OrderPending, models.OrderPaid, true},
+		{models.OrderPending, models.OrderCancelled, true},
+		{models.OrderPending, models.OrderShipped, false},
+		{models.OrderPaid, models.OrderShipped, true},
+		{models.OrderPaid, models.OrderCancelled, true},
+		{models.OrderShipped, models.OrderDelivered, true},
+		{models.OrderShipped, models.OrderCancelled, false},
+		{models.OrderDelivered, models.OrderRefunded, true},
+		{models.OrderCancelled, models.OrderPending, false},
+		{models.OrderCancelled, models.OrderCancelled, false},
+		{models.OrderRefunded, models.OrderPaid, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransition(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestTaxCalculator(t *testing.T) {
+	calc, err := NewTaxCalculator(config.TaxConfig{
+		DefaultRate: 0.1,
+		Rates: []config.TaxRateConfig{
+			{Region: 2, Rate: 0.2},
+			{Country: "US", Rate: 0.05},
+			{Country: "US", State: "CA", Rate: 0.0725},
+		},
+		ExemptCategories: []int{7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	products := []*models.Product{
+		{Id: 1, Price: 1099, Quantity: 2, Category: 1},
+		{Id: 2, Price: 1550, Quantity: 1, Category: 7},
+	}
+	tests := []struct {
+		customer *models.Customer
+		rate     float64
+		tax      models.Money
+	}{
+		// 21.98 * 0.0725 = 1.59355
+		{&models.Customer{Region: 1, Country: "US", State: "CA"}, 0.0725, 159},
+		// 21.98 * 0.05 = 1.099
+		{&models.Customer{Region: 1, Country: "US", State: "NY"}, 0.05, 110},
+		{&models.Customer{Region: 2, Country: "Germany"}, 0.2, 440},
+		// 21.98 * 0.1 = 2.198
+		{&models.Customer{Region: 3, Country: "Chile"}, 0.1, 220},
+	}
+
+	for _, tt := range tests {
+		rate, tax := calc.Tax(tt.customer, products)
+		assert.Equal(t, tt.rate, rate, "rate for %+v", tt.customer)
+		assert.Equal(t, tt.tax, tax, "tax for %+v", tt.customer)
+	}
+}
+
+func TestNewTaxCalculatorInvalidRate(t *testing.T) {
+	_, err := NewTaxCalculator(config.TaxConfig{
+		DefaultRate: 0.1,
+		Rates:       []config.TaxRateConfig{{Country: "US", Rate: 5}},
+	})
+	assert.Error(t, err)
+}
+
+func TestPageCursor(t *testing.T) {
+	sortBy, after, err := pageCursor(models.PageRequest{Limit: 10}, models.ProductSortFields)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SortId, sortBy)
+	assert.Nil(t, after)
+
+	token := pageToken(models.SortPrice, "19.99", 42)
+	sortBy, after, err = pageCursor(models.PageRequest{Limit: 10, SortBy: models.SortPrice, PageToken: token},
+		models.ProductSortFields)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SortPrice, sortBy)
+	assert.Equal(t, &models.Cursor{SortBy: models.SortPrice, Value: "19.99", Id: 42}, after)
+
+	var e *models.ValidationError
+	_, _, err = pageCursor(models.PageRequest{Limit: 10, SortBy: models.SortTitle, PageToken: token},
+		models.ProductSortFields)
+	assert.ErrorAs(t, err, &e)
+	_, _, err = pageCursor(models.PageRequest{Limit: 10, SortBy: models.SortDate}, models.ProductSortFields)
+	assert.ErrorAs(t, err, &e)
+	_, _, err = pageCursor(models.PageRequest{Limit: 10, PageToken: "not a token"}, models.ProductSortFields)
+	assert.ErrorAs(t, err, &e)
+}
+
+func TestOrderRequestHash(t *testing.T) {
+	products := []*models.Product{{Id: 2, Quantity: 1}, {Id: 1, Quantity: 3}}
+	same := []*models.Product{{Id: 1, Quantity: 1}, {Id: 2, Quantity: 1}, {Id: 1, Quantity: 2}}
+	hash := orderRequestHash(5, products, 1)
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, orderRequestHash(5, same, 1))
+	assert.NotEqual(t, hash, orderRequestHash(6, products, 1))
+	assert.NotEqual(t, hash, orderRequestHash(5, products, 2))
+	assert.NotEqual(t, hash, orderRequestHash(5, []*models.Product{{Id: 2, Quantity: 1}, {Id: 1, Quantity: 2}}, 1))
+	assert.NotEqual(t, hash, checkoutRequestHash(5, products, 1))
+	assert.Equal(t, checkoutRequestHash(5, products, 1), checkoutRequestHash(5, same, 1))
+}
+
+func TestCheckPassword(t *testing.T) {
+	hash, err := hashPassword("password1")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		stored, password string
+		ok, legacy       bool
+	}{
+		{hash, "password1", true, false},
+		{hash, "password2", false, false},
+		{"password", "password", true, true},
+		{"password", "Password", false, false},
+		{"", "", false, false},
+		{dummyPasswordHash, "", false, false},
+	}
+	for _, tt := range tests {
+		ok, legacy := checkPassword(tt.stored, tt.password)
+		assert.Equal(t, tt.ok, ok, tt.stored)
+		assert.Equal(t, tt.legacy, legacy, tt.stored)
+	}
+
+	// Unknown username costs the same bcrypt compare as a known one
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	assert.NoError(t, err)
+	assert.Equal(t, bcrypt.DefaultCost, cost)
+}
+
+func TestAuthorizeCustomer(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, models.ErrUnauthenticated, authorizeCustomer(ctx, 5))
+
+	ctx = models.WithIdentity(ctx, &models.Identity{CustomerId: 5, Role: models.RoleCustomer})
+	assert.NoError(t, authorizeCustomer(ctx, 5))
+
+	var permErr *models.PermissionError
+	assert.ErrorAs(t, authorizeCustomer(ctx, 6), &permErr)
+
+	for _, role := range []models.Role{models.RoleAdmin, models.RoleStaff, models.RoleService} {
+		ctx = models.WithIdentity(context.Background(), &models.Identity{CustomerId: 5, Role: role})
+		assert.NoError(t, authorizeCustomer(ctx, 6), role)
+	}
+}
+
+// newTestUC returns use case over memory repository with one customer having a payment
+// method and two products, and context of admin caller
+func newTestUC(t *testing.T) (*dvdstoreUC, context.Context) {
+	repo := memory.NewMemRepo(nil)
+	ctx := models.WithIdentity(context.Background(), &models.Identity{Role: models.RoleAdmin})
+
+	_, err := repo.AddCustomer(ctx, &models.Customer{FirstName: "John", LastName: "Doe", Age: 40,
+		Username: "johndoe"}, "hash")
+	require.NoError(t, err)
+	_, err = repo.AddProduct(ctx, &models.Product{Title: "Interstellar", Price: 1000, Quantity: 5, Category: 1})
+	require.NoError(t, err)
+	_, err = repo.AddProduct(ctx, &models.Product{Title: "Inception", Price: 2500, Quantity: 1, Category: 2})
+	require.NoError(t, err)
+	_, err = repo.AddPaymentMethod(ctx, &models.PaymentMethod{CustomerId: 1, Token: "tok_1",
+		Brand: models.BrandVisa, LastFour: "4242", ExpMonth: 12, ExpYear: time.Now().Year() + 1})
+	require.NoError(t, err)
+
+	taxCalc, err := NewTaxCalculator(config.TaxConfig{DefaultRate: 0.1})
+	require.NoError(t, err)
+	return NewDvdstoreUC(repo, zap.NewNop().Sugar(), models.NewValidation(), taxCalc, nil,
+		payment.NewFakeTokenizer(), payment.NewFakeGateway(), time.Hour, time.Hour), ctx
+}
+
+// failingTokens fails to issue tokens
+type failingTokens struct {
+	dvdstore.TokenManager
+}
+
+func (failingTokens) Issue(customerId int, role models.Role) (string, time.Time, error) {
+	return "", time.Time{}, errors.New("signing key is broken")
+}
+
+func TestLoginTokenFailUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	uc.tokens = failingTokens{}
+	hash, err := hashPassword("password1")
+	require.NoError(t, err)
+	_, err = uc.pg.AddCustomer(ctx, &models.Customer{FirstName: "Jane", LastName: "Doe", Age: 30,
+		Username: "janedoe"}, hash)
+	require.NoError(t, err)
+
+	// Internal error text is not returned to the caller
+	_, _, err = uc.Login(ctx, &models.Credentials{Username: "janedoe", Password: "password1"})
+	assert.Equal(t, models.ErrInternal, err)
+}
+
+func TestAddOrderUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 2}, {Id: 2, Quantity: 1}}, 1, "key")
+	require.NoError(t, err)
+	assert.Equal(t, models.Money(4500), order.NetAmount)
+	assert.Equal(t, models.Money(450), order.Tax)
+	assert.Equal(t, models.Money(4950), order.TotalAmount)
+
+	// Retried request returns the same order without taking products again
+	replayed, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 2}, {Id: 2, Quantity: 1}}, 1, "key")
+	require.NoError(t, err)
+	assert.Equal(t, order.Id, replayed.Id)
+
+	prod, err := uc.GetProduct(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, prod.Quantity)
+
+	var entErr *models.EntityError
+	_, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: 2, Quantity: 1}}, 1, "")
+	assert.ErrorAs(t, err, &entErr)
+	_, err = uc.AddOrder(ctx, 2, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	assert.ErrorAs(t, err, &entErr)
+}
+
+func TestCancelOrderUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 2}}, 1, "")
+	require.NoError(t, err)
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderPaid)
+	require.NoError(t, err)
+
+	require.NoError(t, uc.CancelOrder(ctx, order.Id))
+	prod, err := uc.GetProduct(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, prod.Quantity)
+
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, uc.CancelOrder(ctx, order.Id), &conflictErr)
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderShipped)
+	assert.ErrorAs(t, err, &conflictErr)
+}
+
+func TestAddCustomerUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	customer := &models.Customer{FirstName: "Jane", LastName: "Roe", Age: 30, Username: "janeroe",
+		Address1: "1 Main St", City: "Boston", State: "MA", Zip: "02134", Country: "US",
+		Email: "jane@example.com", Phone: "+1 (617) 555-0100", Income: 50000, Gender: "F"}
+	id, err := uc.AddCustomer(ctx, customer, "password1")
+	require.NoError(t, err)
+
+	got, err := uc.GetCustomer(ctx, id)
+	require.NoError(t, err)
+	customer.Id, customer.Region = id, models.RegionUS
+	assert.Equal(t, customer, got)
+
+	var valErr *models.ValidationError
+	invalid := *customer
+	invalid.Username, invalid.Email = "janeroe2", "jane@"
+	_, err = uc.AddCustomer(ctx, &invalid, "password1")
+	assert.ErrorAs(t, err, &valErr)
+	assert.Contains(t, err.Error(), "email")
+
+	invalid.Email, invalid.Zip = "jane@example.com", ""
+	_, err = uc.AddCustomer(ctx, &invalid, "password1")
+	assert.ErrorAs(t, err, &valErr)
+
+	invalid.Zip, invalid.Gender = "02134", "X"
+	_, err = uc.AddCustomer(ctx, &invalid, "password1")
+	assert.ErrorAs(t, err, &valErr)
+}
+
+func TestUpdateCustomerAddressUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	// Zip alone must match stored country
+	var valErr *models.ValidationError
+	_, err := uc.UpdateCustomer(ctx, &models.Customer{Id: 1, Zip: "90012"}, []string{"Zip"})
+	assert.ErrorAs(t, err, &valErr)
+
+	updated, err := uc.UpdateCustomer(ctx, &models.Customer{Id: 1, State: "CA", Zip: "90012", Country: "US"},
+		[]string{"State", "Zip", "Country"})
+	require.NoError(t, err)
+	assert.Equal(t, models.RegionUS, updated.Region)
+	assert.Equal(t, "John", updated.FirstName)
+
+	_, err = uc.UpdateCustomer(ctx, &models.Customer{Id: 1, Zip: "9001"}, []string{"Zip"})
+	assert.ErrorAs(t, err, &valErr)
+
+	updated, err = uc.UpdateCustomer(ctx, &models.Customer{Id: 1, Zip: "94105-1234"}, []string{"Zip"})
+	require.NoError(t, err)
+	assert.Equal(t, "94105-1234", updated.Zip)
+	assert.Equal(t, "CA", updated.State)
+}
+
+func TestUpdateCustomerEmptyMaskUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	_, err := uc.UpdateCustomer(ctx, &models.Customer{Id: 1, Email: "john@example.com"}, []string{"Email"})
+	require.NoError(t, err)
+
+	// Empty mask updates only name and age, profile fields are kept
+	updated, err := uc.UpdateCustomer(ctx, &models.Customer{Id: 1, FirstName: "Johnny", LastName: "Doe",
+		Age: 41}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Johnny", updated.FirstName)
+	assert.Equal(t, 41, updated.Age)
+	assert.Equal(t, "john@example.com", updated.Email)
+}
+
+func TestPaymentMethodsUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	card := &models.Card{Number: "5555555555554444", ExpMonth: 1, ExpYear: time.Now().Year() + 2, Cvc: "123"}
+	method, err := uc.AddPaymentMethod(ctx, 1, card)
+	require.NoError(t, err)
+	assert.Equal(t, models.BrandMastercard, method.Brand)
+	assert.Equal(t, "4444", method.LastFour)
+	assert.NotContains(t, method.Token, card.Number)
+
+	methods, err := uc.GetPaymentMethods(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, methods, 2)
+
+	var valErr *models.ValidationError
+	expired := *card
+	expired.ExpYear = time.Now().Year() - 1
+	_, err = uc.AddPaymentMethod(ctx, 1, &expired)
+	assert.ErrorAs(t, err, &valErr)
+	declined := *card
+	declined.Number = payment.DeclinedCard
+	_, err = uc.AddPaymentMethod(ctx, 1, &declined)
+	assert.ErrorAs(t, err, &valErr)
+	invalid := *card
+	invalid.Number = "5555555555554445"
+	_, err = uc.AddPaymentMethod(ctx, 1, &invalid)
+	assert.ErrorAs(t, err, &valErr)
+	assert.Contains(t, err.Error(), "number")
+
+	// Customers work only with their own payment methods
+	var permErr *models.PermissionError
+	other := models.WithIdentity(context.Background(), &models.Identity{CustomerId: 2, Role: models.RoleCustomer})
+	_, err = uc.AddPaymentMethod(other, 1, card)
+	assert.ErrorAs(t, err, &permErr)
+	_, err = uc.GetPaymentMethods(other, 1)
+	assert.ErrorAs(t, err, &permErr)
+	assert.ErrorAs(t, uc.DeletePaymentMethod(other, method.Id), &permErr)
+
+	owner := models.WithIdentity(context.Background(), &models.Identity{CustomerId: 1, Role: models.RoleCustomer})
+	require.NoError(t, uc.DeletePaymentMethod(owner, method.Id))
+	var entErr *models.EntityError
+	assert.ErrorAs(t, uc.DeletePaymentMethod(owner, method.Id), &entErr)
+}
+
+func TestAddOrderPaymentMethodUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	products := []*models.Product{{Id: 1, Quantity: 1}}
+
+	order, err := uc.AddOrder(ctx, 1, products, 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, order.PaymentMethodId)
+
+	var valErr *models.ValidationError
+	_, err = uc.AddOrder(ctx, 1, products, 0, "")
+	assert.ErrorAs(t, err, &valErr)
+
+	var entErr *models.EntityError
+	_, err = uc.AddOrder(ctx, 1, products, 100, "")
+	assert.ErrorAs(t, err, &entErr)
+
+	expired, err := uc.pg.AddPaymentMethod(ctx, &models.PaymentMethod{CustomerId: 1, Token: "tok_2",
+		Brand: models.BrandVisa, LastFour: "4242", ExpMonth: 1, ExpYear: time.Now().Year() - 1})
+	require.NoError(t, err)
+	_, err = uc.AddOrder(ctx, 1, products, expired.Id, "")
+	assert.ErrorAs(t, err, &valErr)
+
+	// Payment method of another customer can't be used
+	id, err := uc.AddCustomer(ctx, &models.Customer{FirstName: "Jane", LastName: "Roe", Age: 30,
+		Username: "janeroe"}, "password1")
+	require.NoError(t, err)
+	var permErr *models.PermissionError
+	_, err = uc.AddOrder(ctx, id, products, 1, "")
+	assert.ErrorAs(t, err, &permErr)
+
+	prod, err := uc.GetProduct(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, prod.Quantity)
+}
+
+// recordingGateway is a payment gateway remembering captured, voided and refunded authorizations
+type recordingGateway struct {
+	dvdstore.PaymentGateway
+	captured, voided, refunded []string
+}
+
+func (g *recordingGateway) Capture(ctx context.Context, authorizationId string) error {
+	g.captured = append(g.captured, authorizationId)
+	return g.PaymentGateway.Capture(ctx, authorizationId)
+}
+
+func (g *recordingGateway) Void(ctx context.Context, authorizationId string) error {
+	g.voided = append(g.voided, authorizationId)
+	return g.PaymentGateway.Void(ctx, authorizationId)
+}
+
+func (g *recordingGateway) Refund(ctx context.Context, authorizationId string, amount models.Money) error {
+	g.refunded = append(g.refunded, authorizationId)
+	return g.PaymentGateway.Refund(ctx, authorizationId, amount)
+}
+
+func TestOrderPaymentUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	gateway := &recordingGateway{PaymentGateway: payment.NewFakeGateway()}
+	uc.gateway = gateway
+
+	// Order total is authorized and captured when the order is shipped
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	require.NotNil(t, order.Payment)
+	assert.Equal(t, models.PaymentAuthorized, order.Payment.Status)
+	assert.Equal(t, order.TotalAmount, order.Payment.Amount)
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderPaid)
+	require.NoError(t, err)
+	shipped, err := uc.TransitionOrder(ctx, order.Id, models.OrderShipped)
+	require.NoError(t, err)
+	assert.Equal(t, models.PaymentCaptured, shipped.Payment.Status)
+	assert.Equal(t, []string{order.Payment.AuthorizationId}, gateway.captured)
+
+	// Cancelled order payment is voided
+	order, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	require.NoError(t, uc.CancelOrder(ctx, order.Id))
+	cancelled, err := uc.GetOrder(ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.PaymentVoided, cancelled.Payment.Status)
+	assert.Equal(t, []string{order.Payment.AuthorizationId}, gateway.voided)
+
+	// Authorization is voided if the order wasn't created
+	var entErr *models.EntityError
+	_, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 100}}, 1, "")
+	assert.ErrorAs(t, err, &entErr)
+	assert.Len(t, gateway.voided, 2)
+
+	// Declined payment doesn't take products
+	id, err := uc.pg.AddProduct(ctx, &models.Product{Title: "Tenet", Price: payment.AuthorizationLimit,
+		Quantity: 1, Category: 1})
+	require.NoError(t, err)
+	var valErr *models.ValidationError
+	_, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: id, Quantity: 1}}, 1, "")
+	assert.ErrorAs(t, err, &valErr)
+	prod, err := uc.GetProduct(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 1, prod.Quantity)
+}
+
+// cancellingRepo cancels order concurrently right before its status is updated
+type cancellingRepo struct {
+	dvdstore.PostgresRepo
+}
+
+func (r cancellingRepo) UpdateOrderStatus(ctx context.Context, orderId int, from,
+	to models.OrderStatus) error {
+	if err := r.PostgresRepo.CancelOrder(ctx, orderId, from); err != nil {
+		return err
+	}
+	return r.PostgresRepo.UpdateOrderStatus(ctx, orderId, from, to)
+}
+
+// failingGateway fails to capture and refund payments
+type failingGateway struct {
+	dvdstore.PaymentGateway
+}
+
+func (failingGateway) Capture(ctx context.Context, authorizationId string) error {
+	return errors.New("gateway is unavailable")
+}
+
+func (failingGateway) Refund(ctx context.Context, authorizationId string, amount models.Money) error {
+	return errors.New("gateway is unavailable")
+}
+
+func TestShipOrderPaymentUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	gateway := &recordingGateway{PaymentGateway: payment.NewFakeGateway()}
+	uc.gateway = gateway
+
+	// Order cancelled concurrently is not charged
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderPaid)
+	require.NoError(t, err)
+	repo := uc.pg
+	uc.pg = cancellingRepo{PostgresRepo: repo}
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderShipped)
+	var conflictErr *models.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.Empty(t, gateway.captured)
+	uc.pg = repo
+	got, err := uc.GetOrder(ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrderCancelled, got.Status)
+	assert.Equal(t, models.PaymentAuthorized, got.Payment.Status)
+
+	// Order stays paid if payment wasn't captured
+	order, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderPaid)
+	require.NoError(t, err)
+	uc.gateway = failingGateway{PaymentGateway: gateway}
+	_, err = uc.TransitionOrder(ctx, order.Id, models.OrderShipped)
+	assert.ErrorIs(t, err, models.ErrPaymentFail)
+	got, err = uc.GetOrder(ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrderPaid, got.Status)
+	assert.Equal(t, models.PaymentAuthorized, got.Payment.Status)
+}
+
+func TestReturnOrderLinesUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	gateway := &recordingGateway{PaymentGateway: payment.NewFakeGateway()}
+	uc.gateway = gateway
+
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 2}, {Id: 2, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+
+	// Only delivered orders are returned
+	var conflictErr *models.ConflictError
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 1}})
+	assert.ErrorAs(t, err, &conflictErr)
+	for _, status := range []models.OrderStatus{models.OrderPaid, models.OrderShipped, models.OrderDelivered} {
+		_, err = uc.TransitionOrder(ctx, order.Id, status)
+		require.NoError(t, err)
+	}
+
+	ret, err := uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, models.Money(1000), ret.NetAmount)
+	assert.Equal(t, models.Money(100), ret.Tax)
+	assert.Equal(t, models.Money(1100), ret.TotalAmount)
+	assert.Equal(t, models.RefundRefunded, ret.RefundStatus)
+	assert.Equal(t, []string{order.Payment.AuthorizationId}, gateway.refunded)
+	prod, err := uc.GetProduct(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, prod.Quantity)
+
+	// Order amounts are not changed and the return is listed
+	got, err := uc.GetOrder(ctx, order.Id)
+	require.NoError(t, err)
+	assert.Equal(t, order.TotalAmount, got.TotalAmount)
+	require.Len(t, got.Returns, 1)
+	assert.Equal(t, ret.Id, got.Returns[0].Id)
+	assert.Equal(t, models.RefundRefunded, got.Returns[0].RefundStatus)
+
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 1}, {Id: 1, Quantity: 1}})
+	assert.ErrorAs(t, err, &conflictErr)
+	var entErr *models.EntityError
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 3, Quantity: 1}})
+	assert.ErrorAs(t, err, &entErr)
+	var valErr *models.ValidationError
+	_, err = uc.ReturnOrderLines(ctx, order.Id, nil)
+	assert.ErrorAs(t, err, &valErr)
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 0}})
+	assert.ErrorAs(t, err, &valErr)
+
+	// Return is recorded with pending refund if gateway failed
+	uc.gateway = failingGateway{PaymentGateway: gateway}
+	ret, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 2, Quantity: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, models.RefundPending, ret.RefundStatus)
+	got, err = uc.GetOrder(ctx, order.Id)
+	require.NoError(t, err)
+	require.Len(t, got.Returns, 2)
+	assert.Equal(t, models.RefundPending, got.Returns[1].RefundStatus)
+}
+
+func TestRefundOrderUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+	gateway := &recordingGateway{PaymentGateway: payment.NewFakeGateway()}
+	uc.gateway = gateway
+
+	order, err := uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 2}, {Id: 2, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	for _, status := range []models.OrderStatus{models.OrderPaid, models.OrderShipped, models.OrderDelivered} {
+		_, err = uc.TransitionOrder(ctx, order.Id, status)
+		require.NoError(t, err)
+	}
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 1}})
+	require.NoError(t, err)
+
+	// Refunded order returns and refunds not returned products
+	refunded, err := uc.TransitionOrder(ctx, order.Id, models.OrderRefunded)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrderRefunded, refunded.Status)
+	require.Len(t, refunded.Returns, 2)
+	assert.Equal(t, []*models.Product{{Id: 1, Title: "Interstellar", Price: 1000, Quantity: 1, Category: 1},
+		{Id: 2, Title: "Inception", Price: 2500, Quantity: 1, Category: 2}}, refunded.Returns[1].Products)
+	assert.Equal(t, order.TotalAmount, refunded.Returns[0].TotalAmount+refunded.Returns[1].TotalAmount)
+	assert.Len(t, gateway.refunded, 2)
+	for id, want := range map[int]int{1: 5, 2: 1} {
+		prod, err := uc.GetProduct(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, want, prod.Quantity)
+	}
+
+	// Fully returned order is refunded without another return
+	order, err = uc.AddOrder(ctx, 1, []*models.Product{{Id: 1, Quantity: 1}}, 1, "")
+	require.NoError(t, err)
+	for _, status := range []models.OrderStatus{models.OrderPaid, models.OrderShipped, models.OrderDelivered} {
+		_, err = uc.TransitionOrder(ctx, order.Id, status)
+		require.NoError(t, err)
+	}
+	_, err = uc.ReturnOrderLines(ctx, order.Id, []*models.Product{{Id: 1, Quantity: 1}})
+	require.NoError(t, err)
+	refunded, err = uc.TransitionOrder(ctx, order.Id, models.OrderRefunded)
+	require.NoError(t, err)
+	assert.Len(t, refunded.Returns, 1)
+}
+
+func TestPriceReturn(t *testing.T) {
+	uc, _ := newTestUC(t)
+	order := &models.Order{Id: 1, NetAmount: 2997, Tax: 247, TaxRate: 0.0825,
+		Products: []*models.Product{{Id: 1, Price: 999, Quantity: 3}}}
+
+	// Returns are priced at order prices and tax rate
+	ret := uc.priceReturn(order, []*models.Product{{Id: 1, Price: 999, Quantity: 1}})
+	assert.Equal(t, models.Money(999), ret.NetAmount)
+	assert.Equal(t, models.Money(82), ret.Tax)
+	assert.Equal(t, models.Money(1081), ret.TotalAmount)
+}
+
+func TestCartUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	cart, err := uc.GetCart(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+	assert.True(t, cart.ExpiresAt.IsZero())
+
+	// Cart has current prices and availability of products in stock
+	_, err = uc.AddToCart(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	cart, err = uc.AddToCart(ctx, 1, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 2)
+	assert.True(t, cart.Items[0].Available())
+	assert.False(t, cart.Items[1].Available())
+	assert.Equal(t, models.Money(7000), cart.NetAmount())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), cart.ExpiresAt, time.Minute)
+
+	// Unavailable products are not ordered and the cart is kept
+	var entErr *models.EntityError
+	_, err = uc.Checkout(ctx, 1, 1, "")
+	assert.ErrorAs(t, err, &entErr)
+	cart, err = uc.RemoveFromCart(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 1)
+	_, err = uc.RemoveFromCart(ctx, 1, 2)
+	assert.ErrorAs(t, err, &entErr)
+
+	// Checkout places the order and removes ordered items, retry returns the same order
+	order, err := uc.Checkout(ctx, 1, 1, "key")
+	require.NoError(t, err)
+	assert.Equal(t, models.Money(2000), order.NetAmount)
+	assert.Equal(t, models.Money(2200), order.TotalAmount)
+	cart, err = uc.GetCart(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+	replayed, err := uc.Checkout(ctx, 1, 1, "key")
+	require.NoError(t, err)
+	assert.Equal(t, order.Id, replayed.Id)
+	prod, err := uc.GetProduct(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, prod.Quantity)
+
+	// Key reused with another cart or payment method is rejected
+	var conflictErr *models.ConflictError
+	_, err = uc.Checkout(ctx, 1, 2, "key")
+	assert.ErrorAs(t, err, &conflictErr)
+	_, err = uc.AddToCart(ctx, 1, 1, 1)
+	require.NoError(t, err)
+	_, err = uc.Checkout(ctx, 1, 1, "key")
+	assert.ErrorAs(t, err, &conflictErr)
+	_, err = uc.RemoveFromCart(ctx, 1, 1)
+	require.NoError(t, err)
+
+	var valErr *models.ValidationError
+	_, err = uc.Checkout(ctx, 1, 1, "")
+	assert.ErrorAs(t, err, &valErr)
+	_, err = uc.AddToCart(ctx, 1, 1, 0)
+	assert.ErrorAs(t, err, &valErr)
+	_, err = uc.AddToCart(ctx, 1, 1, math.MaxInt32+1)
+	assert.ErrorAs(t, err, &valErr)
+	_, err = uc.AddToCart(ctx, 1, 1, math.MaxInt32)
+	require.NoError(t, err)
+	_, err = uc.AddToCart(ctx, 1, 1, 1)
+	assert.ErrorAs(t, err, &valErr)
+
+	// Customers work only with their own cart
+	customerCtx := models.WithIdentity(context.Background(), &models.Identity{Role: models.RoleCustomer,
+		CustomerId: 2})
+	var permissionErr *models.PermissionError
+	_, err = uc.AddToCart(customerCtx, 1, 1, 1)
+	assert.ErrorAs(t, err, &permissionErr)
+}
+
+// cartAddingRepo adds product 1 to the cart of the customer while the order is placed
+type cartAddingRepo struct {
+	dvdstore.PostgresRepo
+}
+
+func (r cartAddingRepo) AddOrder(ctx context.Context, customerId int, order *models.Order,
+	key *models.IdempotencyKey) (*models.Order, error) {
+	if err := r.PostgresRepo.AddCartItem(ctx, customerId, 1, 1); err != nil {
+		return nil, err
+	}
+	return r.PostgresRepo.AddOrder(ctx, customerId, order, key)
+}
+
+func TestCheckoutConcurrentAddUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	// Items added during checkout stay in the cart
+	_, err := uc.AddToCart(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	repo := uc.pg
+	uc.pg = cartAddingRepo{PostgresRepo: repo}
+	order, err := uc.Checkout(ctx, 1, 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, order.Products[0].Quantity)
+	uc.pg = repo
+	cart, err := uc.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 1)
+	assert.Equal(t, 1, cart.Items[0].Quantity)
+}
+
+// expiringCartRepo returns the next read cart as expired, when expire is set
+type expiringCartRepo struct {
+	dvdstore.PostgresRepo
+	expire bool
+}
+
+func (r *expiringCartRepo) GetCart(ctx context.Context, customerId int) (*models.Cart, error) {
+	cart, err := r.PostgresRepo.GetCart(ctx, customerId)
+	if err == nil && r.expire {
+		cart.UpdatedAt = cart.UpdatedAt.Add(-2 * time.Hour)
+		r.expire = false
+	}
+	return cart, err
+}
+
+func TestCartExpiryUC(t *testing.T) {
+	uc, ctx := newTestUC(t)
+
+	// Expired cart is emptied
+	_, err := uc.AddToCart(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	uc.cartExpiry = 0
+	cart, err := uc.GetCart(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+
+	// Items of expired cart are not added up with new ones
+	uc.cartExpiry = time.Hour
+	repo := &expiringCartRepo{PostgresRepo: uc.pg}
+	uc.pg = repo
+	_, err = uc.AddToCart(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	repo.expire = true
+	cart, err = uc.AddToCart(ctx, 1, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 1)
+	assert.Equal(t, 1, cart.Items[0].Quantity)
+}
@@ -0,0 +1,113 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Checker keeps serving status of the app services according to the database
+// availability. Status is served by grpc.health.v1 and HTTP probes
+type Checker struct {
+	hs       *health.Server
+	ping     func(ctx context.Context) error
+	interval time.Duration
+	services []string
+	log      *zap.SugaredLogger
+}
+
+// NewChecker returns checker of passed services that pings the database every interval.
+// Services are NOT_SERVING until the first successful ping. Empty service name stands
+// for the overall server status
+func NewChecker(ping func(ctx context.Context) error, interval time.Duration,
+	log *zap.SugaredLogger, services ...string) *Checker {
+	c := &Checker{
+		hs:       health.NewServer(),
+		ping:     ping,
+		interval: interval,
+		services: append([]string{""}, services...),
+		log:      log,
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server returns grpc health server to register
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.hs
+}
+
+// Run pings the database until context is done and updates services status
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check pings the database once and updates services status
+func (c *Checker) Check(ctx context.Context) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err := c.ping(ctx); err != nil {
+		c.log.Errorf("Health check: %v", err)
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.setStatus(status)
+}
+
+// Shutdown sets all services to NOT_SERVING and ignores further checks.
+// It's called before graceful stop so that new requests are not routed to the app
+func (c *Checker) Shutdown() {
+	c.hs.Shutdown()
+}
+
+// Ready reports whether the app is serving
+func (c *Checker) Ready(ctx context.Context) bool {
+	res, err := c.hs.Check(ctx, &healthpb.HealthCheckRequest{})
+	return err == nil && res.GetStatus() == healthpb.HealthCheckResponse_SERVING
+}
+
+// LivenessHandler returns HTTP handler of liveness probe. The app is alive while
+// it can respond, database availability doesn't matter
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeStatus(w, http.StatusOK, "ok")
+	})
+}
+
+// ReadinessHandler returns HTTP handler of readiness probe. Responds with
+// 503 Service Unavailable if the app is not serving
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.Ready(r.Context()) {
+			writeStatus(w, http.StatusServiceUnavailable, healthpb.HealthCheckResponse_NOT_SERVING.String())
+			return
+		}
+		writeStatus(w, http.StatusOK, healthpb.HealthCheckResponse_SERVING.String())
+	})
+}
+
+// setStatus sets status of all services
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, s := range c.services {
+		c.hs.SetServingStatus(s, status)
+	}
+}
+
+// writeStatus writes probe response with JSON status
+func writeStatus(w http.ResponseWriter, code int, status string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write([]byte(`{"status":"` + status + `"}`))
+}
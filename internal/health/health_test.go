@@ -0,0 +1,58 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func serviceStatus(t *testing.T, c *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	res, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	assert.NoError(t, err)
+	return res.GetStatus()
+}
+
+func readyz(c *Checker) int {
+	rec := httptest.NewRecorder()
+	c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestChecker(t *testing.T) {
+	var pingErr error
+	ping := func(ctx context.Context) error { return pingErr }
+	c := NewChecker(ping, time.Second, zap.NewNop().Sugar(), "proto.Dvdstore")
+
+	// Not serving until the first check
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, serviceStatus(t, c, "proto.Dvdstore"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(c))
+
+	c.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, serviceStatus(t, c, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, serviceStatus(t, c, "proto.Dvdstore"))
+	assert.Equal(t, http.StatusOK, readyz(c))
+
+	pingErr = errors.New("connection refused")
+	c.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, serviceStatus(t, c, "proto.Dvdstore"))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(c))
+
+	// Checks are ignored after shutdown
+	pingErr = nil
+	c.Check(context.Background())
+	c.Shutdown()
+	c.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, serviceStatus(t, c, ""))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz(c))
+
+	rec := httptest.NewRecorder()
+	c.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
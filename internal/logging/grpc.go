@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor assigns request id to every call or accepts one from x-request-id
+// metadata, puts request logger to the handler context and logs the call outcome
+func UnaryServerInterceptor(log *zap.SugaredLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if ids := md.Get(RequestIDHeader); len(ids) > 0 {
+				id = ids[0]
+			}
+		}
+		id = requestID(id)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id)); err != nil {
+			log.Debugf("grpc.SetHeader: %v", err)
+		}
+
+		l := requestLogger(ctx, log, id).With("method", info.FullMethod)
+		if p, ok := peer.FromContext(ctx); ok {
+			l = l.With("peer", p.Addr.String())
+		}
+
+		resp, err := handler(WithLogger(ctx, l), req)
+
+		code := status.Code(err)
+		fields := []interface{}{"code", code.String(), "duration", time.Since(start)}
+		if serverError(code) {
+			l.Errorw("Finished call", append(fields, "error", err)...)
+		} else {
+			l.Infow("Finished call", fields...)
+		}
+		return resp, err
+	}
+}
+
+// serverError reports whether code means the server failed to handle the call
+func serverError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
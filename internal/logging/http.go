@@ -0,0 +1,46 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// statusWriter remembers response status code
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Middleware assigns request id to every request or accepts one from X-Request-Id header,
+// puts request logger to the request context and logs the request outcome
+func Middleware(log *zap.SugaredLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			id := requestID(r.Header.Get(RequestIDHeader))
+			w.Header().Set(RequestIDHeader, id)
+
+			ctx := r.Context()
+			l := requestLogger(ctx, log, id).With("method", r.Method, "path", r.URL.Path,
+				"peer", r.RemoteAddr)
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(WithLogger(ctx, l)))
+
+			fields := []interface{}{"status", sw.status, "duration", time.Since(start)}
+			if sw.status >= http.StatusInternalServerError {
+				l.Errorw("Finished request", fields...)
+			} else {
+				l.Infow("Finished request", fields...)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/alexzh7/sample-service/config"
+)
+
+// RequestIDHeader is a header and grpc metadata key of request id
+const RequestIDHeader = "x-request-id"
+
+// validRequestID matches request ids accepted from clients
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// ctxKey is a context key of request logger
+type ctxKey struct{}
+
+// NewLogger returns logger with level and encoding ("json" or "console") from passed config
+func NewLogger(c config.LogConfig) (*zap.SugaredLogger, error) {
+	level, err := zap.ParseAtomicLevel(c.Level)
+	if err != nil {
+		return nil, fmt.Errorf("zap.ParseAtomicLevel: %v", err)
+	}
+
+	zc := zap.NewProductionConfig()
+	zc.Level = level
+	zc.Encoding = c.Encoding
+	if c.Encoding == "console" {
+		zc.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+	}
+
+	l, err := zc.Build()
+	if err != nil {
+		return nil, fmt.Errorf("zap.Build: %v", err)
+	}
+	return l.Sugar(), nil
+}
+
+// WithLogger returns context carrying passed logger
+func WithLogger(ctx context.Context, log *zap.SugaredLogger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, log)
+}
+
+// FromContext returns request logger from context or fallback if there is none
+func FromContext(ctx context.Context, fallback *zap.SugaredLogger) *zap.SugaredLogger {
+	if l, ok := ctx.Value(ctxKey{}).(*zap.SugaredLogger); ok {
+		return l
+	}
+	return fallback
+}
+
+// requestID returns id passed by client if it's valid or generates a new one
+func requestID(id string) string {
+	if validRequestID.MatchString(id) {
+		return id
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// requestLogger returns child logger with request id and trace id of the span in context
+func requestLogger(ctx context.Context, log *zap.SugaredLogger, id string) *zap.SugaredLogger {
+	log = log.With("request_id", id)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		log = log.With("trace_id", sc.TraceID().String())
+	}
+	return log
+}
@@ -0,0 +1,106 @@
+package logging
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexzh7/sample-service/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newObserved() (*zap.SugaredLogger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	return zap.New(core).Sugar(), logs
+}
+
+func TestNewLogger(t *testing.T) {
+	_, err := NewLogger(config.LogConfig{Level: "debug", Encoding: "console"})
+	assert.NoError(t, err)
+
+	_, err = NewLogger(config.LogConfig{Level: "loud", Encoding: "json"})
+	assert.Error(t, err)
+}
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "req-1", requestID("req-1"))
+	assert.Len(t, requestID(""), 32)
+	assert.Len(t, requestID("bad\nid"), 32)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	log, logs := newObserved()
+	interceptor := UnaryServerInterceptor(log)
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.Dvdstore/GetOrder"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "req-1"))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		FromContext(ctx, nil).Info("handling")
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	_, err := interceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	entries := logs.All()
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "req-1", entries[0].ContextMap()["request_id"])
+		assert.Equal(t, info.FullMethod, entries[0].ContextMap()["method"])
+
+		access := entries[1]
+		assert.Equal(t, zapcore.InfoLevel, access.Level)
+		assert.Equal(t, "req-1", access.ContextMap()["request_id"])
+		assert.Equal(t, "NotFound", access.ContextMap()["code"])
+	}
+}
+
+func TestUnaryServerInterceptorServerError(t *testing.T) {
+	log, logs := newObserved()
+	interceptor := UnaryServerInterceptor(log)
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.Dvdstore/GetOrder"}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "database failure")
+	}
+	_, err := interceptor(context.Background(), nil, info, handler)
+	assert.Error(t, err)
+
+	entries := logs.FilterMessage("Finished call").All()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, zapcore.ErrorLevel, entries[0].Level)
+		assert.Len(t, entries[0].ContextMap()["request_id"], 32)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	log, logs := newObserved()
+	handler := Middleware(log)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		FromContext(r.Context(), nil).Info("handling")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/orders/7", nil)
+	req.Header.Set(RequestIDHeader, "req-2")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, "req-2", rec.Header().Get(RequestIDHeader))
+	entries := logs.All()
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "req-2", entries[0].ContextMap()["request_id"])
+		assert.Equal(t, int64(http.StatusNotFound), entries[1].ContextMap()["status"])
+		assert.Equal(t, "/v1/orders/7", entries[1].ContextMap()["path"])
+	}
+}
+
+func TestFromContextFallback(t *testing.T) {
+	log, _ := newObserved()
+	assert.Same(t, log, FromContext(context.Background(), log))
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// namespace prefixes names of all app metrics
+const namespace = "dvdstore"
+
+// Metrics keeps app collectors in its own registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	ordersPlaced   prometheus.Counter
+	ordersRevenue  prometheus.Counter
+	outOfInventory prometheus.Counter
+}
+
+// New returns Metrics with RPC, business, Go runtime and process collectors registered
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of handled RPCs by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of handled RPCs by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		ordersPlaced: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "placed_total",
+			Help:      "Number of placed orders.",
+		}),
+		ordersRevenue: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "revenue_cents_total",
+			Help:      "Total amount of placed orders in cents.",
+		}),
+		outOfInventory: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "out_of_inventory_total",
+			Help:      "Number of orders rejected because products are out of inventory.",
+		}),
+	}
+	m.registry.MustRegister(
+		m.rpcRequests,
+		m.rpcDuration,
+		m.ordersPlaced,
+		m.ordersRevenue,
+		m.outOfInventory,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// RegisterDB registers connection pool stats of db
+func (m *Metrics) RegisterDB(db *sql.DB, dbName string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Handler returns HTTP handler exposing metrics in Prometheus format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// UnaryServerInterceptor counts RPCs by method and status code and observes their latency
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.rpcDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		m.rpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return resp, err
+	}
+}
+
+// OrderPlaced counts placed order and its total amount
+func (m *Metrics) OrderPlaced(total models.Money) {
+	m.ordersPlaced.Inc()
+	m.ordersRevenue.Add(float64(total))
+}
+
+// OutOfInventory counts order rejected because of insufficient inventory
+func (m *Metrics) OutOfInventory() {
+	m.outOfInventory.Inc()
+}
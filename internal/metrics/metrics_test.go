@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	m := New()
+	interceptor := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/dvdstore.Dvdstore/GetProduct"}
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	notFound := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	res, err := interceptor(context.Background(), nil, info, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+	_, err = interceptor(context.Background(), nil, info, notFound)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.rpcRequests.WithLabelValues(info.FullMethod, "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.rpcRequests.WithLabelValues(info.FullMethod, "NotFound")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rpcDuration))
+}
+
+func TestOrderCounters(t *testing.T) {
+	m := New()
+	m.OrderPlaced(2599)
+	m.OrderPlaced(1000)
+	m.OutOfInventory()
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.ordersPlaced))
+	assert.Equal(t, float64(3599), testutil.ToFloat64(m.ordersRevenue))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.outOfInventory))
+}
+
+func TestHandler(t *testing.T) {
+	m := New()
+	m.OrderPlaced(2599)
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), "dvdstore_orders_placed_total 1"))
+}
@@ -0,0 +1,53 @@
+package models
+
+import "context"
+
+// Credentials are customer username and password
+type Credentials struct {
+	Username string `validate:"required,min=3,max=50,alphanum"`
+	Password string `validate:"required,min=8,max=72"`
+}
+
+// Role is a role of the caller, it defines which methods the caller may call
+type Role string
+
+// Roles of callers. Customers work with their own data, staff manages catalog and orders,
+// admins may also delete entities. Services are internal callers authenticated with API keys
+const (
+	RoleAdmin    Role = "admin"
+	RoleStaff    Role = "staff"
+	RoleCustomer Role = "customer"
+	RoleService  Role = "service"
+)
+
+// Valid reports if role is known
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleStaff, RoleCustomer, RoleService:
+		return true
+	}
+	return false
+}
+
+// Identity is an authenticated caller. CustomerId is set for customer accounts of any
+// role, Name is set for services
+type Identity struct {
+	CustomerId int
+	Name       string
+	Role       Role
+}
+
+// identityKey is a context key of caller identity
+type identityKey struct{}
+
+// WithIdentity returns context carrying identity of authenticated caller
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns identity of authenticated caller and false if caller
+// is anonymous
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsValidation(t *testing.T) {
+	val := NewValidation()
+	assert.NoError(t, val.Struct(&Credentials{Username: "johndoe", Password: "password1"}))
+	assert.Error(t, val.Struct(&Credentials{Username: "john doe", Password: "password1"}))
+	assert.Error(t, val.Struct(&Credentials{Username: "johndoe", Password: "short"}))
+}
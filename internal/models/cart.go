@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/alexzh7/sample-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CartItem is a product in the customer cart. Product has current title, price and
+// category, its quantity is a quantity in stock
+type CartItem struct {
+	Product *Product
+	// Quantity is a quantity of the product in the cart
+	Quantity int
+}
+
+// Available reports if there is enough of the product in stock for the cart quantity
+func (c *CartItem) Available() bool {
+	return c.Quantity <= c.Product.Quantity
+}
+
+// Map models.CartItem to proto.CartItem
+func (c *CartItem) ToProto() *proto.CartItem {
+	return &proto.CartItem{
+		Product:   c.Product.ToProto(),
+		Quantity:  int64(c.Quantity),
+		Available: c.Available(),
+	}
+}
+
+// Cart is a customer cart with items sorted by product id. Cart expires after a period
+// without changes, ExpiresAt is zero for empty cart
+type Cart struct {
+	CustomerId int
+	Items      []*CartItem
+	UpdatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+// NetAmount returns sum of the cart items at current prices
+func (c *Cart) NetAmount() Money {
+	var net Money
+	for _, item := range c.Items {
+		net += item.Product.Price.Mul(item.Quantity)
+	}
+	return net
+}
+
+// Products returns cart items as products of the order with cart quantities
+func (c *Cart) Products() []*Product {
+	products := make([]*Product, 0, len(c.Items))
+	for _, item := range c.Items {
+		products = append(products, &Product{Id: item.Product.Id, Quantity: item.Quantity})
+	}
+	return products
+}
+
+// Map models.Cart to proto.Cart
+func (c *Cart) ToProto() *proto.Cart {
+	items := make([]*proto.CartItem, 0, len(c.Items))
+	for _, item := range c.Items {
+		items = append(items, item.ToProto())
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if !c.ExpiresAt.IsZero() {
+		expiresAt = timestamppb.New(c.ExpiresAt)
+	}
+
+	return &proto.Cart{
+		CustomerID: int64(c.CustomerId),
+		ItemList:   items,
+		NetAmount:  c.NetAmount().ToProto(),
+		ExpiresAt:  expiresAt,
+	}
+}
@@ -8,6 +8,12 @@ import (
 // ErrGeneralDBFail is used to hide db errors from client
 var ErrGeneralDBFail = errors.New("unexpected database error")
 
+// ErrInternal is used to hide internal errors that are not caused by db from client
+var ErrInternal = errors.New("unexpected internal error")
+
+// ErrPaymentFail is used to hide errors of payment provider from client
+var ErrPaymentFail = errors.New("payment provider is unavailable, try again")
+
 // EntityError represents all errors that can be exposed to the user,
 // for example "order/product/customer not found"
 type EntityError struct {
@@ -29,6 +35,88 @@ func ErrOutOfInventory(entity string, id int) *EntityError {
 	return &EntityError{Entity: entity, Message: fmt.Sprintf("id %v is out of inventory", id)}
 }
 
+// ConflictError represents errors caused by the current state of the entity,
+// for example "order is already cancelled"
+type ConflictError struct {
+	Entity  string
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%v %v", e.Entity, e.Message)
+}
+
+// ErrAlreadyCancelled composes "already cancelled" errors for provided entities
+func ErrAlreadyCancelled(entity string, id int) *ConflictError {
+	return &ConflictError{Entity: entity, Message: fmt.Sprintf("id %v is already cancelled", id)}
+}
+
+// ErrInvalidTransition composes errors for forbidden status transitions of provided entities
+func ErrInvalidTransition(entity string, id int, from, to string) *ConflictError {
+	return &ConflictError{
+		Entity:  entity,
+		Message: fmt.Sprintf("id %v can not change status from %v to %v", id, from, to),
+	}
+}
+
+// ErrStatusChanged composes errors for entities which status was changed concurrently
+func ErrStatusChanged(entity string, id int) *ConflictError {
+	return &ConflictError{Entity: entity, Message: fmt.Sprintf("id %v status was changed, try again", id)}
+}
+
+// ErrReturnNotAllowed composes errors for entities which can't be returned in their status
+func ErrReturnNotAllowed(entity string, id int, status string) *ConflictError {
+	return &ConflictError{Entity: entity, Message: fmt.Sprintf("id %v can not be returned in %v status", id, status)}
+}
+
+// ErrNotReturnable composes errors for returns exceeding not yet returned quantity of the product
+func ErrNotReturnable(entity string, id int) *ConflictError {
+	return &ConflictError{Entity: entity, Message: fmt.Sprintf("id %v quantity exceeds not returned quantity", id)}
+}
+
+// ErrAlreadyExists composes errors for entities which unique name is already taken
+func ErrAlreadyExists(entity string, name string) *ConflictError {
+	return &ConflictError{Entity: entity, Message: fmt.Sprintf("%q already exists", name)}
+}
+
+// ErrKeyReused composes errors for idempotency keys reused with another request
+func ErrKeyReused(key string) *ConflictError {
+	return &ConflictError{
+		Entity:  "idempotency key",
+		Message: fmt.Sprintf("%q was already used with another request", key),
+	}
+}
+
+// AuthError represents errors of missing or invalid credentials
+type AuthError struct {
+	Message string
+}
+
+func (a *AuthError) Error() string {
+	return a.Message
+}
+
+// ErrUnauthenticated is returned if method requires authenticated caller
+var ErrUnauthenticated = &AuthError{Message: "authentication required"}
+
+// ErrInvalidCredentials is returned if username or password is wrong
+var ErrInvalidCredentials = &AuthError{Message: "invalid username or password"}
+
+// PermissionError represents errors of authenticated caller accessing entities of others
+type PermissionError struct {
+	Entity  string
+	Message string
+}
+
+func (p *PermissionError) Error() string {
+	return fmt.Sprintf("%v %v", p.Entity, p.Message)
+}
+
+// ErrPermissionDenied composes "permission denied" errors for provided entities
+func ErrPermissionDenied(entity string, id int) *PermissionError {
+	return &PermissionError{Entity: entity, Message: fmt.Sprintf("id %v is not accessible", id)}
+}
+
 // ValidationError represents validation errors
 type ValidationError struct {
 	Message string
@@ -42,3 +130,8 @@ func (v *ValidationError) Error() string {
 func ErrFieldsNotValid(fieldNames ...string) *ValidationError {
 	return &ValidationError{Message: fmt.Sprintf("%q fields must be valid", fieldNames)}
 }
+
+// ErrCurrencyNotValid composes validation error for money fields with foreign currency
+func ErrCurrencyNotValid(fieldName string) *ValidationError {
+	return &ValidationError{Message: fmt.Sprintf("%q currency must be %v", fieldName, Currency)}
+}
@@ -9,11 +9,25 @@ import (
 
 // Customer model
 type Customer struct {
-	Id        int      `json:"id,omitempty"`
-	FirstName string   `json:"firstName,omitempty" validate:"required,max=50"`
-	LastName  string   `json:"lastName,omitempty" validate:"required,max=50"`
-	Age       int      `json:"age,omitempty" validate:"required,gt=0,max=150"`
-	Orders    []*Order `json:"orders,omitempty"`
+	Id        int    `json:"id,omitempty"`
+	FirstName string `json:"firstName,omitempty" validate:"required,max=50"`
+	LastName  string `json:"lastName,omitempty" validate:"required,max=50"`
+	Age       int    `json:"age,omitempty" validate:"required,gt=0,max=150"`
+	Username  string `json:"username,omitempty" validate:"required,min=3,max=50,alphanum"`
+	Address1  string `json:"address1,omitempty" validate:"max=50"`
+	Address2  string `json:"address2,omitempty" validate:"max=50"`
+	City      string `json:"city,omitempty" validate:"max=50"`
+	State     string `json:"state,omitempty" validate:"max=50"`
+	// Zip must match Country, see ValidateAddress
+	Zip     string `json:"zip,omitempty" validate:"max=10"`
+	Country string `json:"country,omitempty" validate:"max=50"`
+	// Region is derived from Country, see RegionOf
+	Region int      `json:"region,omitempty"`
+	Email  string   `json:"email,omitempty" validate:"omitempty,max=50,email"`
+	Phone  string   `json:"phone,omitempty" validate:"omitempty,phone"`
+	Income int      `json:"income,omitempty" validate:"gte=0,int"`
+	Gender string   `json:"gender,omitempty" validate:"omitempty,oneof=M F"`
+	Orders []*Order `json:"orders,omitempty"`
 }
 
 // Map models.Customer to proto.Customer
@@ -23,15 +37,80 @@ func (c *Customer) ToProto() *proto.Customer {
 		FirstName: c.FirstName,
 		LastName:  c.LastName,
 		Age:       int64(c.Age),
+		Username:  c.Username,
+		Address1:  c.Address1,
+		Address2:  c.Address2,
+		City:      c.City,
+		State:     c.State,
+		Zip:       c.Zip,
+		Country:   c.Country,
+		Region:    int64(c.Region),
+		Email:     c.Email,
+		Phone:     c.Phone,
+		Income:    int64(c.Income),
+		Gender:    c.Gender,
 	}
 }
 
+// CustomerFromProto maps proto.Customer to models.Customer. Region is not mapped,
+// it's derived from country
+func CustomerFromProto(customer *proto.Customer) *Customer {
+	return &Customer{
+		Id:        int(customer.GetId()),
+		FirstName: customer.GetFirstName(),
+		LastName:  customer.GetLastName(),
+		Age:       int(customer.GetAge()),
+		Username:  customer.GetUsername(),
+		Address1:  customer.GetAddress1(),
+		Address2:  customer.GetAddress2(),
+		City:      customer.GetCity(),
+		State:     customer.GetState(),
+		Zip:       customer.GetZip(),
+		Country:   customer.GetCountry(),
+		Email:     customer.GetEmail(),
+		Phone:     customer.GetPhone(),
+		Income:    int(customer.GetIncome()),
+		Gender:    customer.GetGender(),
+	}
+}
+
+// CustomerUpdatableFields lists customer fields that can be changed by update
+var CustomerUpdatableFields = []string{"FirstName", "LastName", "Age", "Address1", "Address2", "City",
+	"State", "Zip", "Country", "Email", "Phone", "Income", "Gender"}
+
+// CustomerDefaultUpdateFields lists customer fields updated if update mask is empty. They are
+// the fields updatable before profile fields were added, so clients that don't know profile
+// fields don't overwrite them
+var CustomerDefaultUpdateFields = []string{"FirstName", "LastName", "Age"}
+
+// CustomerAddressFields lists customer fields that are validated together by ValidateAddress
+var CustomerAddressFields = []string{"State", "Zip", "Country"}
+
+// Regions of Dell DVD store customers
+const (
+	RegionUnknown = 0
+	RegionUS      = 1
+	RegionWorld   = 2
+)
+
+// RegionOf returns region of the country
+func RegionOf(country string) int {
+	switch country {
+	case "":
+		return RegionUnknown
+	case "US":
+		return RegionUS
+	}
+	return RegionWorld
+}
+
 // Product model
 type Product struct {
-	Id       int     `json:"id,omitempty" validate:"required,gte=0,int"`
-	Title    string  `json:"title,omitempty" validate:"required,max=50"`
-	Price    float64 `json:"price,omitempty" validate:"required,gte=0,float"`
-	Quantity int     `json:"quantity,omitempty" validate:"required,gte=0,int"`
+	Id       int    `json:"id,omitempty" validate:"required,gte=0,int"`
+	Title    string `json:"title,omitempty" validate:"required,max=50"`
+	Price    Money  `json:"price,omitempty" validate:"required,gt=0"`
+	Quantity int    `json:"quantity,omitempty" validate:"required,gte=0,int"`
+	Category int    `json:"category,omitempty" validate:"required,gt=0"`
 }
 
 // Map models.Product to proto.Product
@@ -39,8 +118,9 @@ func (p *Product) ToProto() *proto.Product {
 	return &proto.Product{
 		Id:       int64(p.Id),
 		Title:    p.Title,
-		Price:    p.Price,
+		Price:    p.Price.ToProto(),
 		Quantity: int64(p.Quantity),
+		Category: int64(p.Category),
 	}
 }
 
@@ -49,19 +129,168 @@ func ProductFromProto(product *proto.Product) *Product {
 	return &Product{
 		Id:       int(product.GetId()),
 		Title:    product.GetTitle(),
-		Price:    product.GetPrice(),
+		Price:    MoneyFromProto(product.GetPrice()),
 		Quantity: int(product.GetQuantity()),
+		Category: int(product.GetCategory()),
+	}
+}
+
+// ProductUpdatableFields lists product fields that can be changed by update.
+// Quantity is changed only by inventory adjustments
+var ProductUpdatableFields = []string{"Title", "Price", "Category"}
+
+// Category model
+type Category struct {
+	Id   int    `json:"id,omitempty" validate:"required,gte=0,int"`
+	Name string `json:"name,omitempty" validate:"required,max=50"`
+}
+
+// Map models.Category to proto.Category
+func (c *Category) ToProto() *proto.Category {
+	return &proto.Category{
+		Id:   int64(c.Id),
+		Name: c.Name,
+	}
+}
+
+// CategoryFromProto maps proto.Category to models.Category
+func CategoryFromProto(category *proto.Category) *Category {
+	return &Category{
+		Id:   int(category.GetId()),
+		Name: category.GetName(),
 	}
 }
 
+// ProductFilter describes product search conditions. Zero-valued conditions
+// are not applied
+type ProductFilter struct {
+	// Query is a full-text query matched against product title and actor
+	Query string `validate:"max=100"`
+	// Categories limits products to the listed categories
+	Categories []int `validate:"dive,gt=0"`
+	// MinPrice and MaxPrice limit product price range, bounds are inclusive
+	MinPrice *Money `validate:"omitempty,gte=0"`
+	MaxPrice *Money `validate:"omitempty,gte=0"`
+	// InStockOnly excludes products that are out of inventory
+	InStockOnly bool
+}
+
 // Order model
 type Order struct {
-	Id          int        `json:"id,omitempty"`
-	Date        time.Time  `json:"date,omitempty"`
-	NetAmount   float64    `json:"netamount,omitempty"`
-	Tax         float64    `json:"tax,omitempty"`
-	TotalAmount float64    `json:"totalamount,omitempty"`
-	Products    []*Product `json:"products,omitempty"`
+	Id          int         `json:"id,omitempty"`
+	Date        time.Time   `json:"date,omitempty"`
+	NetAmount   Money       `json:"netamount,omitempty"`
+	Tax         Money       `json:"tax,omitempty"`
+	TaxRate     float64     `json:"taxrate,omitempty"`
+	TotalAmount Money       `json:"totalamount,omitempty"`
+	Products    []*Product  `json:"products,omitempty"`
+	Status      OrderStatus `json:"status,omitempty"`
+	// PaymentMethodId is 0 if the order has no payment method
+	PaymentMethodId int `json:"paymentmethodid,omitempty"`
+	// Payment is nil for orders placed before payments
+	Payment *Payment `json:"payment,omitempty"`
+	// Returns are returns of the order products sorted by id
+	Returns []*OrderReturn `json:"returns,omitempty"`
+}
+
+// OrderReturn is a return of ordered products. Products have order prices and returned
+// quantities, amounts are refunded at the order tax rate
+type OrderReturn struct {
+	Id          int
+	OrderId     int
+	Date        time.Time
+	Products    []*Product
+	NetAmount   Money
+	Tax         Money
+	TotalAmount Money
+	// RefundStatus tells if total amount of the return was refunded to the customer
+	RefundStatus RefundStatus
+}
+
+// Map models.OrderReturn to proto.OrderReturn
+func (r *OrderReturn) ToProto() *proto.OrderReturn {
+	products := make([]*proto.Product, 0, len(r.Products))
+	for _, p := range r.Products {
+		products = append(products, p.ToProto())
+	}
+
+	return &proto.OrderReturn{
+		Id:           int64(r.Id),
+		OrderID:      int64(r.OrderId),
+		Date:         timestamppb.New(r.Date),
+		ProductList:  products,
+		NetAmount:    r.NetAmount.ToProto(),
+		Tax:          r.Tax.ToProto(),
+		TotalAmount:  r.TotalAmount.ToProto(),
+		RefundStatus: r.RefundStatus.ToProto(),
+	}
+}
+
+// LimitRefund limits refunded amounts of the return by not refunded amounts of the order.
+// The return of all not returned products refunds exactly what is left, so rounding of
+// partial returns adds up to the order
+func (r *OrderReturn) LimitRefund(leftNet, leftTax Money, returnsAll bool) {
+	if returnsAll || r.NetAmount > leftNet {
+		r.NetAmount = leftNet
+	}
+	if returnsAll || r.Tax > leftTax {
+		r.Tax = leftTax
+	}
+	r.TotalAmount = r.NetAmount + r.Tax
+}
+
+// IdempotencyKey is a client provided key of the order request. Retried request
+// with the same key returns the order created by the first one until key expires
+type IdempotencyKey struct {
+	Key string
+	// RequestHash identifies request payload the key was used with
+	RequestHash string
+	OrderId     int
+	ExpiresAt   time.Time
+}
+
+// OrderStatus is a status of the order
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "pending"
+	OrderPaid      OrderStatus = "paid"
+	OrderShipped   OrderStatus = "shipped"
+	OrderDelivered OrderStatus = "delivered"
+	OrderCancelled OrderStatus = "cancelled"
+	OrderRefunded  OrderStatus = "refunded"
+)
+
+// orderStatusProto maps order statuses to proto.OrderStatus
+var orderStatusProto = map[OrderStatus]proto.OrderStatus{
+	OrderPending:   proto.OrderStatus_ORDER_STATUS_PENDING,
+	OrderPaid:      proto.OrderStatus_ORDER_STATUS_PAID,
+	OrderShipped:   proto.OrderStatus_ORDER_STATUS_SHIPPED,
+	OrderDelivered: proto.OrderStatus_ORDER_STATUS_DELIVERED,
+	OrderCancelled: proto.OrderStatus_ORDER_STATUS_CANCELLED,
+	OrderRefunded:  proto.OrderStatus_ORDER_STATUS_REFUNDED,
+}
+
+// Valid reports whether status is one of the known order statuses
+func (s OrderStatus) Valid() bool {
+	_, ok := orderStatusProto[s]
+	return ok
+}
+
+// Map models.OrderStatus to proto.OrderStatus
+func (s OrderStatus) ToProto() proto.OrderStatus {
+	return orderStatusProto[s]
+}
+
+// OrderStatusFromProto maps proto.OrderStatus to models.OrderStatus.
+// Returns empty status for unknown values
+func OrderStatusFromProto(status proto.OrderStatus) OrderStatus {
+	for s, p := range orderStatusProto {
+		if p == status {
+			return s
+		}
+	}
+	return ""
 }
 
 // Map models.Order to proto.Order
@@ -74,13 +303,27 @@ func (o *Order) ToProto() *proto.Order {
 		}
 	}
 
+	var payment *proto.Payment
+	if o.Payment != nil {
+		payment = o.Payment.ToProto()
+	}
+	returns := make([]*proto.OrderReturn, 0, len(o.Returns))
+	for _, r := range o.Returns {
+		returns = append(returns, r.ToProto())
+	}
+
 	return &proto.Order{
-		Id:          int64(o.Id),
-		Date:        timestamppb.New(o.Date),
-		NetAmount:   o.NetAmount,
-		Tax:         o.Tax,
-		TotalAmount: o.TotalAmount,
-		ProductList: products,
+		Id:              int64(o.Id),
+		Date:            timestamppb.New(o.Date),
+		NetAmount:       o.NetAmount.ToProto(),
+		Tax:             o.Tax.ToProto(),
+		TaxRate:         o.TaxRate,
+		TotalAmount:     o.TotalAmount.ToProto(),
+		ProductList:     products,
+		Status:          o.Status.ToProto(),
+		PaymentMethodID: int64(o.PaymentMethodId),
+		Payment:         payment,
+		ReturnList:      returns,
 	}
 }
 
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerAddressValidation(t *testing.T) {
+	tests := []struct {
+		state, zip, country string
+		valid               bool
+	}{
+		{"", "", "", true},
+		{"CA", "90012", "US", true},
+		{"NY", "10001-1234", "US", true},
+		{"CA", "9001", "US", false},
+		{"", "90012", "US", false},
+		{"", "SW1A 1AA", "UK", true},
+		{"", "", "Japan", true},
+		{"", "12#45", "Chile", false},
+		{"", "90012", "", false},
+		{"CA", "", "", false},
+	}
+	for _, tt := range tests {
+		c := &Customer{State: tt.state, Zip: tt.zip, Country: tt.country}
+		assert.Equal(t, tt.valid, c.ValidateAddress() == nil, "%+v", tt)
+	}
+}
+
+func TestOrderReturnLimitRefund(t *testing.T) {
+	// Order of 3 x 9.99 at 8.25% has 2.47 tax, partial returns round tax to 0.82
+	leftNet, leftTax := Money(2997), Money(247)
+	for i, wantTax := range []Money{82, 82, 83} {
+		ret := &OrderReturn{NetAmount: 999, Tax: 82}
+		ret.LimitRefund(leftNet, leftTax, i == 2)
+		assert.Equal(t, Money(999), ret.NetAmount)
+		assert.Equal(t, wantTax, ret.Tax)
+		assert.Equal(t, 999+wantTax, ret.TotalAmount)
+		leftNet, leftTax = leftNet-ret.NetAmount, leftTax-ret.Tax
+	}
+
+	// Refund never exceeds what is left
+	ret := &OrderReturn{NetAmount: 1000, Tax: 100}
+	ret.LimitRefund(500, 50, false)
+	assert.Equal(t, Money(550), ret.TotalAmount)
+}
+
+func TestProductFilterValidation(t *testing.T) {
+	val := NewValidation()
+	negative := Money(-1)
+
+	assert.NoError(t, val.Struct(&ProductFilter{}))
+	assert.NoError(t, val.Struct(&ProductFilter{Query: "academy", Categories: []int{1, 2}}))
+	assert.Error(t, val.Struct(&ProductFilter{Categories: []int{0}}))
+	assert.Error(t, val.Struct(&ProductFilter{MinPrice: &negative}))
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alexzh7/sample-service/proto"
+)
+
+// Currency is a currency of all prices and amounts of the store
+const Currency = "USD"
+
+// Money is an amount of money in minor units (cents). Money is scanned from and
+// stored to NUMERIC columns as exact decimal
+type Money int64
+
+// ParseMoney parses decimal string like "25.99" to Money. Returns error if amount
+// has non-zero digits after cents
+func ParseMoney(s string) (Money, error) {
+	str := strings.TrimSpace(s)
+	neg := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(str, "-")
+
+	units, frac, _ := strings.Cut(str, ".")
+	if units == "" && frac == "" {
+		return 0, fmt.Errorf("ParseMoney: invalid amount %q", s)
+	}
+	if len(frac) > 2 {
+		if strings.Trim(frac[2:], "0") != "" {
+			return 0, fmt.Errorf("ParseMoney: amount %q has fractions of cents", s)
+		}
+		frac = frac[:2]
+	}
+	frac += strings.Repeat("0", 2-len(frac))
+	if units == "" {
+		units = "0"
+	}
+
+	amount, err := strconv.ParseInt(units+frac, 10, 64)
+	if err != nil || strings.ContainsAny(units+frac, "+-") {
+		return 0, fmt.Errorf("ParseMoney: invalid amount %q", s)
+	}
+	if neg {
+		amount = -amount
+	}
+	return Money(amount), nil
+}
+
+// String formats money as decimal, e.g. "25.99"
+func (m Money) String() string {
+	sign, amount := "", int64(m)
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%v%d.%02d", sign, amount/100, amount%100)
+}
+
+// Mul returns money multiplied by quantity
+func (m Money) Mul(quantity int) Money {
+	return m * Money(quantity)
+}
+
+// MulRate returns money multiplied by rate and rounded to cents half away from zero.
+// Rate is used with precision of 6 decimal places, e.g. 0.0725 or 0.08875
+func (m Money) MulRate(rate float64) Money {
+	ppm := int64(math.Round(rate * 1e6))
+	product := int64(m) * ppm
+	if product < 0 {
+		return Money((product - 500000) / 1e6)
+	}
+	return Money((product + 500000) / 1e6)
+}
+
+// Scan implements sql.Scanner interface. NUMERIC columns are scanned from their
+// decimal text representation
+func (m *Money) Scan(src interface{}) error {
+	var err error
+	switch v := src.(type) {
+	case []byte:
+		*m, err = ParseMoney(string(v))
+	case string:
+		*m, err = ParseMoney(v)
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		*m = Money(math.Round(v * 100))
+	default:
+		err = fmt.Errorf("Money.Scan: unsupported type %T", src)
+	}
+	return err
+}
+
+// Value implements driver.Valuer interface. Money is stored as decimal text
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Map models.Money to proto.Money
+func (m Money) ToProto() *proto.Money {
+	return &proto.Money{Currency: Currency, Amount: int64(m)}
+}
+
+// MoneyFromProto maps proto.Money to models.Money. Currency is not checked,
+// use ValidCurrency for it
+func MoneyFromProto(money *proto.Money) Money {
+	return Money(money.GetAmount())
+}
+
+// ValidCurrency reports whether money has the store currency. Empty currency
+// is treated as the store currency
+func ValidCurrency(money *proto.Money) bool {
+	return money.GetCurrency() == "" || money.GetCurrency() == Currency
+}
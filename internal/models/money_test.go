@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Money
+		ok   bool
+	}{
+		{"25.99", 2599, true},
+		{"25.9", 2590, true},
+		{"25", 2500, true},
+		{"0.05", 5, true},
+		{".5", 50, true},
+		{"-3.10", -310, true},
+		{"12.3400", 1234, true},
+		{"12.345", 0, false},
+		{"", 0, false},
+		{"abc", 0, false},
+		{"1.-5", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if !tt.ok {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	assert.Equal(t, "25.99", Money(2599).String())
+	assert.Equal(t, "0.05", Money(5).String())
+	assert.Equal(t, "-3.10", Money(-310).String())
+}
+
+func TestMoneyMulRate(t *testing.T) {
+	tests := []struct {
+		m    Money
+		rate float64
+		want Money
+	}{
+		{1000, 0.1, 100},
+		{2198, 0.0725, 159},  // 1.59355
+		{1010, 0.08875, 90},  // 0.896375
+		{50, 0.1, 5},         // 0.05
+		{25, 0.1, 3},         // 0.025 is rounded half away from zero
+		{-25, 0.1, -3},       // -0.025 is rounded half away from zero
+		{1999, 0.175, 350},   // 3.49825
+		{100000, 0.07, 7000}, // 70.00
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.m.MulRate(tt.rate), "%v * %v", tt.m, tt.rate)
+	}
+}
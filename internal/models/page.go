@@ -0,0 +1,89 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Sort fields of lists
+const (
+	SortId       = "id"
+	SortTitle    = "title"
+	SortPrice    = "price"
+	SortLastName = "last_name"
+	SortDate     = "date"
+)
+
+var (
+	// CustomerSortFields lists fields customers can be sorted by
+	CustomerSortFields = []string{SortId, SortLastName}
+	// ProductSortFields lists fields products can be sorted by
+	ProductSortFields = []string{SortId, SortTitle, SortPrice}
+	// OrderSortFields lists fields orders can be sorted by
+	OrderSortFields = []string{SortId, SortDate}
+)
+
+// PageRequest describes requested page of the list
+type PageRequest struct {
+	// Limit is a max number of items on the page
+	Limit int
+	// SortBy is a field to sort list by, list is sorted by id if empty
+	SortBy string
+	// PageToken is a token of the page returned with previous page, empty for the first page
+	PageToken string
+}
+
+// Cursor is a position in sorted list. Next page starts after the item with
+// sort field Value and Id
+type Cursor struct {
+	SortBy string `json:"s"`
+	Value  string `json:"v,omitempty"`
+	Id     int    `json:"i"`
+}
+
+// EncodePageToken encodes cursor to opaque page token
+func EncodePageToken(c *Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodePageToken decodes page token to cursor. Returns ValidationError if token is not valid
+func DecodePageToken(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrFieldsNotValid("pageToken")
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Id <= 0 {
+		return nil, ErrFieldsNotValid("pageToken")
+	}
+	return &c, nil
+}
+
+// SortValue returns customer value of sort field
+func (c *Customer) SortValue(sortBy string) string {
+	if sortBy == SortLastName {
+		return c.LastName
+	}
+	return ""
+}
+
+// SortValue returns product value of sort field
+func (p *Product) SortValue(sortBy string) string {
+	switch sortBy {
+	case SortTitle:
+		return p.Title
+	case SortPrice:
+		return p.Price.String()
+	}
+	return ""
+}
+
+// SortValue returns order value of sort field
+func (o *Order) SortValue(sortBy string) string {
+	if sortBy == SortDate {
+		return o.Date.Format(time.RFC3339Nano)
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alexzh7/sample-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Card is a payment card passed by the customer. Number and CVC are only
+// passed to the tokenizer and never stored
+type Card struct {
+	Number   string `validate:"required,numeric,min=12,max=19,luhn"`
+	ExpMonth int    `validate:"min=1,max=12"`
+	ExpYear  int    `validate:"min=2000,max=9999"`
+	Cvc      string `validate:"required,numeric,min=3,max=4"`
+}
+
+// CardFromProto maps proto.Card to models.Card
+func CardFromProto(card *proto.Card) *Card {
+	return &Card{
+		Number:   card.GetNumber(),
+		ExpMonth: int(card.GetExpMonth()),
+		ExpYear:  int(card.GetExpYear()),
+		Cvc:      card.GetCvc(),
+	}
+}
+
+// Card brands detected by card number prefix
+const (
+	BrandVisa       = "visa"
+	BrandMastercard = "mastercard"
+	BrandAmex       = "amex"
+	BrandDiscover   = "discover"
+	BrandUnknown    = "unknown"
+)
+
+// Brand returns card brand detected by card number prefix
+func (c *Card) Brand() string {
+	n := c.Number
+	switch {
+	case strings.HasPrefix(n, "4"):
+		return BrandVisa
+	case prefixBetween(n, 2, 51, 55) || prefixBetween(n, 4, 2221, 2720):
+		return BrandMastercard
+	case strings.HasPrefix(n, "34") || strings.HasPrefix(n, "37"):
+		return BrandAmex
+	case strings.HasPrefix(n, "6011") || strings.HasPrefix(n, "65"):
+		return BrandDiscover
+	}
+	return BrandUnknown
+}
+
+// prefixBetween reports if the first n digits of number are in [from, to] range
+func prefixBetween(number string, n, from, to int) bool {
+	if len(number) < n {
+		return false
+	}
+	prefix := 0
+	for _, d := range number[:n] {
+		prefix = prefix*10 + int(d-'0')
+	}
+	return prefix >= from && prefix <= to
+}
+
+// LastFour returns last four digits of the card number
+func (c *Card) LastFour() string {
+	if len(c.Number) < 4 {
+		return c.Number
+	}
+	return c.Number[len(c.Number)-4:]
+}
+
+// Expired reports if the card is expired at passed time
+func (c *Card) Expired(now time.Time) bool {
+	return expired(c.ExpMonth, c.ExpYear, now)
+}
+
+// expired reports if card expiring at passed month and year is expired at passed time.
+// Card is valid through the last day of its expiry month
+func expired(month, year int, now time.Time) bool {
+	return !now.UTC().Before(time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// PaymentMethod is a tokenized payment card of the customer. Card number is
+// kept only by the tokenizer, the store keeps its token, brand, last four digits
+// and expiry
+type PaymentMethod struct {
+	Id         int
+	CustomerId int
+	// Token references the card in the tokenizer
+	Token     string
+	Brand     string
+	LastFour  string
+	ExpMonth  int
+	ExpYear   int
+	CreatedAt time.Time
+}
+
+// Expired reports if the card of payment method is expired at passed time
+func (p *PaymentMethod) Expired(now time.Time) bool {
+	return expired(p.ExpMonth, p.ExpYear, now)
+}
+
+// Map models.PaymentMethod to proto.PaymentMethod. Token is not exposed
+func (p *PaymentMethod) ToProto() *proto.PaymentMethod {
+	return &proto.PaymentMethod{
+		Id:         int64(p.Id),
+		CustomerID: int64(p.CustomerId),
+		Brand:      p.Brand,
+		LastFour:   p.LastFour,
+		ExpMonth:   int64(p.ExpMonth),
+		ExpYear:    int64(p.ExpYear),
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+	}
+}
+
+// PaymentStatus is a status of the order payment
+type PaymentStatus string
+
+// Payment is authorized on order placement, then either captured or voided
+const (
+	PaymentAuthorized PaymentStatus = "authorized"
+	PaymentCaptured   PaymentStatus = "captured"
+	PaymentVoided     PaymentStatus = "voided"
+)
+
+// paymentStatusProto maps payment statuses to proto.PaymentStatus
+var paymentStatusProto = map[PaymentStatus]proto.PaymentStatus{
+	PaymentAuthorized: proto.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+	PaymentCaptured:   proto.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+	PaymentVoided:     proto.PaymentStatus_PAYMENT_STATUS_VOIDED,
+}
+
+// Map models.PaymentStatus to proto.PaymentStatus
+func (s PaymentStatus) ToProto() proto.PaymentStatus {
+	return paymentStatusProto[s]
+}
+
+// RefundStatus is a status of the return refund
+type RefundStatus string
+
+// Return is recorded with pending refund, then it's refunded by the payment gateway
+const (
+	RefundPending  RefundStatus = "pending"
+	RefundRefunded RefundStatus = "refunded"
+)
+
+// refundStatusProto maps refund statuses to proto.RefundStatus
+var refundStatusProto = map[RefundStatus]proto.RefundStatus{
+	RefundPending:  proto.RefundStatus_REFUND_STATUS_PENDING,
+	RefundRefunded: proto.RefundStatus_REFUND_STATUS_REFUNDED,
+}
+
+// Map models.RefundStatus to proto.RefundStatus
+func (s RefundStatus) ToProto() proto.RefundStatus {
+	return refundStatusProto[s]
+}
+
+// Payment is a payment of the order by its payment method
+type Payment struct {
+	Id      int
+	OrderId int
+	// AuthorizationId references the authorization in the payment gateway
+	AuthorizationId string
+	Amount          Money
+	Status          PaymentStatus
+}
+
+// Map models.Payment to proto.Payment. Authorization id is not exposed
+func (p *Payment) ToProto() *proto.Payment {
+	return &proto.Payment{
+		Id:     int64(p.Id),
+		Amount: p.Amount.ToProto(),
+		Status: p.Status.ToProto(),
+	}
+}
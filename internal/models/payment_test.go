@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardValidation(t *testing.T) {
+	val := NewValidation()
+	card := Card{Number: "4242424242424242", ExpMonth: 12, ExpYear: 2030, Cvc: "123"}
+	assert.NoError(t, val.Struct(&card))
+
+	invalid := card
+	invalid.Number = "4242424242424241"
+	assert.Error(t, val.Struct(&invalid))
+	invalid.Number = "4242-4242-4242-4242"
+	assert.Error(t, val.Struct(&invalid))
+	invalid = card
+	invalid.ExpMonth = 13
+	assert.Error(t, val.Struct(&invalid))
+	invalid = card
+	invalid.Cvc = "12"
+	assert.Error(t, val.Struct(&invalid))
+
+	brands := map[string]string{
+		"4242424242424242": BrandVisa,
+		"5555555555554444": BrandMastercard,
+		"2223003122003222": BrandMastercard,
+		"378282246310005":  BrandAmex,
+		"6011111111111117": BrandDiscover,
+		"3566002020360505": BrandUnknown,
+	}
+	for number, brand := range brands {
+		c := &Card{Number: number}
+		assert.Equal(t, brand, c.Brand(), number)
+	}
+
+	// Card is valid through the last day of its expiry month
+	assert.False(t, card.Expired(time.Date(2030, 12, 31, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, card.Expired(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
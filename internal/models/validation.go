@@ -2,6 +2,7 @@ package models
 
 import (
 	"math"
+	"regexp"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -16,6 +17,8 @@ func NewValidation() *Validation {
 	v := validator.New()
 	v.RegisterValidation("float", validFloat)
 	v.RegisterValidation("int", validInt)
+	v.RegisterValidation("phone", validPhone)
+	v.RegisterValidation("luhn", validLuhn)
 
 	return &Validation{Validate: v}
 }
@@ -38,3 +41,54 @@ func validFloat(field validator.FieldLevel) bool {
 
 	return true
 }
+
+// phoneRegexp matches phone numbers of 7-15 digits with optional leading plus,
+// spaces, dashes and parentheses
+var phoneRegexp = regexp.MustCompile(`^\+?[0-9][0-9 ()-]{5,18}[0-9]$`)
+
+// validPhone checks phone number format
+func validPhone(field validator.FieldLevel) bool {
+	return phoneRegexp.MatchString(field.Field().String())
+}
+
+// validLuhn checks Luhn checksum of a digit string, e.g. a card number
+func validLuhn(field validator.FieldLevel) bool {
+	digits := field.Field().String()
+	sum := 0
+	for i := range digits {
+		d := int(digits[len(digits)-1-i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		// Double every second digit from the right
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return digits != "" && sum%10 == 0
+}
+
+var (
+	// usZipRegexp matches US ZIP and ZIP+4 codes
+	usZipRegexp = regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`)
+	// zipRegexp matches postal codes of other countries
+	zipRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 -]{1,9}$`)
+)
+
+// ValidateAddress checks that zip and state are consistent with country: US addresses
+// have state and ZIP code, addresses of other countries may have postal code and
+// address without country has neither. Returns ValidationError if they are not
+func (c *Customer) ValidateAddress() error {
+	switch {
+	case c.Country == "" && (c.Zip != "" || c.State != ""):
+		return &ValidationError{Message: "country is required with zip and state"}
+	case c.Country == "US" && (c.State == "" || !usZipRegexp.MatchString(c.Zip)):
+		return &ValidationError{Message: "US address must have state and zip of 5 or 5+4 digits"}
+	case c.Country != "US" && c.Zip != "" && !zipRegexp.MatchString(c.Zip):
+		return &ValidationError{Message: "zip must be 2 to 10 letters, digits, spaces or dashes"}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// AuthorizationLimit is the largest amount the fake gateway authorizes, $10,000
+const AuthorizationLimit models.Money = 1000000
+
+// fakeAuthorizationPrefix prefixes authorization ids of the fake gateway
+const fakeAuthorizationPrefix = "auth_fake_"
+
+// fakeGateway authorizes payments without moving money. Authorization ids are sequential,
+// so the gateway is deterministic. It implements PaymentGateway interface
+type fakeGateway struct {
+	lastId int64
+}
+
+// NewFakeGateway returns payment gateway of the fake provider
+func NewFakeGateway() *fakeGateway {
+	return &fakeGateway{}
+}
+
+// Authorize returns authorization id "auth_fake_N", where N is a sequence number of
+// the authorization. Returns ValidationError if amount is over AuthorizationLimit
+func (f *fakeGateway) Authorize(ctx context.Context, token string, amount models.Money) (string, error) {
+	if amount > AuthorizationLimit {
+		return "", &models.ValidationError{Message: "payment was declined"}
+	}
+	return fmt.Sprintf("%v%v", fakeAuthorizationPrefix, atomic.AddInt64(&f.lastId, 1)), nil
+}
+
+// Capture accepts every authorization of the fake gateway
+func (f *fakeGateway) Capture(ctx context.Context, authorizationId string) error {
+	if !strings.HasPrefix(authorizationId, fakeAuthorizationPrefix) {
+		return fmt.Errorf("fakeGateway.Capture: unknown authorization %q", authorizationId)
+	}
+	return nil
+}
+
+// Void accepts every authorization of the fake gateway
+func (f *fakeGateway) Void(ctx context.Context, authorizationId string) error {
+	if !strings.HasPrefix(authorizationId, fakeAuthorizationPrefix) {
+		return fmt.Errorf("fakeGateway.Void: unknown authorization %q", authorizationId)
+	}
+	return nil
+}
+
+// Refund accepts every authorization of the fake gateway
+func (f *fakeGateway) Refund(ctx context.Context, authorizationId string, amount models.Money) error {
+	if !strings.HasPrefix(authorizationId, fakeAuthorizationPrefix) {
+		return fmt.Errorf("fakeGateway.Refund: unknown authorization %q", authorizationId)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeTokenizer(t *testing.T) {
+	tokenizer := NewFakeTokenizer()
+	card := &models.Card{Number: "4242424242424242", ExpMonth: 12, ExpYear: 2030, Cvc: "123"}
+
+	first, err := tokenizer.Tokenize(context.Background(), card)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(first, "tok_fake_"))
+	assert.NotContains(t, first, card.Number)
+	second, err := tokenizer.Tokenize(context.Background(), card)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	card.Number = DeclinedCard
+	_, err = tokenizer.Tokenize(context.Background(), card)
+	var validationErr *models.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestFakeGateway(t *testing.T) {
+	gateway := NewFakeGateway()
+	ctx := context.Background()
+
+	first, err := gateway.Authorize(ctx, "tok_fake_1", 2599)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth_fake_1", first)
+	second, err := gateway.Authorize(ctx, "tok_fake_1", AuthorizationLimit)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth_fake_2", second)
+
+	_, err = gateway.Authorize(ctx, "tok_fake_1", AuthorizationLimit+1)
+	var validationErr *models.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	assert.NoError(t, gateway.Capture(ctx, first))
+	assert.NoError(t, gateway.Void(ctx, second))
+	assert.NoError(t, gateway.Refund(ctx, first, 100))
+	assert.Error(t, gateway.Capture(ctx, "auth_other_1"))
+	assert.Error(t, gateway.Void(ctx, "auth_other_1"))
+	assert.Error(t, gateway.Refund(ctx, "auth_other_1", 100))
+}
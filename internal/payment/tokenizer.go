@@ -0,0 +1,37 @@
+// Package payment has providers of card payments. Fake provider keeps nothing
+// outside of the process and is meant for tests and local runs
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/alexzh7/sample-service/internal/models"
+)
+
+// DeclinedCard is a card number the fake provider always declines
+const DeclinedCard = "4000000000000002"
+
+// fakeTokenizer issues random tokens without keeping cards. It implements
+// CardTokenizer interface
+type fakeTokenizer struct{}
+
+// NewFakeTokenizer returns tokenizer of the fake provider
+func NewFakeTokenizer() *fakeTokenizer {
+	return &fakeTokenizer{}
+}
+
+// Tokenize returns random token prefixed with "tok_fake_". Returns ValidationError
+// if card number is DeclinedCard
+func (f *fakeTokenizer) Tokenize(ctx context.Context, card *models.Card) (string, error) {
+	if card.Number == DeclinedCard {
+		return "", &models.ValidationError{Message: "card was declined"}
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("fakeTokenizer.Tokenize: %v", err)
+	}
+	return "tok_fake_" + hex.EncodeToString(b), nil
+}
@@ -1,25 +1,47 @@
 package server
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/alexzh7/sample-service/config"
+	"github.com/alexzh7/sample-service/internal/auth"
+	"github.com/alexzh7/sample-service/internal/dvdstore"
 	service "github.com/alexzh7/sample-service/internal/dvdstore/grpc"
+	"github.com/alexzh7/sample-service/internal/dvdstore/repository/memory"
 	repo "github.com/alexzh7/sample-service/internal/dvdstore/repository/postgres"
+	"github.com/alexzh7/sample-service/internal/dvdstore/rest"
 	"github.com/alexzh7/sample-service/internal/dvdstore/usecase"
+	"github.com/alexzh7/sample-service/internal/health"
+	"github.com/alexzh7/sample-service/internal/logging"
+	"github.com/alexzh7/sample-service/internal/metrics"
 	"github.com/alexzh7/sample-service/internal/models"
+	"github.com/alexzh7/sample-service/internal/payment"
+	"github.com/alexzh7/sample-service/pkg/migrate"
+	"github.com/alexzh7/sample-service/pkg/postgres"
+	"github.com/alexzh7/sample-service/pkg/tlsconfig"
 	"github.com/alexzh7/sample-service/proto"
+	"github.com/alexzh7/sample-service/schema"
 	"go.uber.org/zap"
 )
 
+// shutdownTimeout is how long the server waits for active HTTP requests on shutdown
+const shutdownTimeout = 10 * time.Second
+
 // Server is application server struct
 type Server struct {
 	config *config.Config
@@ -27,14 +49,18 @@ type Server struct {
 	dbConn *sql.DB
 }
 
-// NewServer returns new application server
+// NewServer returns new application server. Database connection is nil
+// if memory repository is used
 func NewServer(config *config.Config, log *zap.SugaredLogger, dbConn *sql.DB) *Server {
 	return &Server{config: config, log: log, dbConn: dbConn}
 }
 
 func (s *Server) Run() error {
-	// New repository
-	pgRepo, err := repo.NewPgRepo(s.dbConn)
+	// New metrics
+	m := metrics.New()
+
+	// New repository of configured driver
+	pgRepo, ping, err := s.newRepository(m)
 	if err != nil {
 		s.log.Fatal(err)
 	}
@@ -42,14 +68,62 @@ func (s *Server) Run() error {
 	// New validator
 	validator := models.NewValidation()
 
-	// New use case
-	uc := usecase.NewDvdstoreUC(pgRepo, s.log, validator)
+	// New tax calculator
+	taxCalc, err := usecase.NewTaxCalculator(s.config.Tax)
+	if err != nil {
+		s.log.Fatal(err)
+	}
 
-	// New grpc server
-	grpcSrv := grpc.NewServer()
+	// New token manager
+	tokens, err := auth.NewTokenManager(s.config.Auth.TokenSecret, s.config.Auth.TokenTTL)
+	if err != nil {
+		s.log.Fatal(err)
+	}
+
+	// New API keys of service callers
+	apiKeys, err := auth.NewAPIKeys(s.config.Auth.APIKeys)
+	if err != nil {
+		s.log.Fatal(err)
+	}
+
+	// New card tokenizer and payment gateway. Fake is the only payments provider so far
+	tokenizer, gateway := payment.NewFakeTokenizer(), payment.NewFakeGateway()
+	s.log.Warnf("Using %v payments provider", s.config.Payments.Provider)
+
+	// New use case
+	uc := usecase.NewDvdstoreUC(pgRepo, s.log, validator, taxCalc, tokens, tokenizer, gateway,
+		s.config.Orders.IdempotencyRetention, s.config.Orders.CartExpiry)
+
+	// New grpc server, with TLS if certificate is set
+	grpcOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(s.log),
+		m.UnaryServerInterceptor(),
+		service.AuthInterceptor(tokens, apiKeys),
+	)}
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	defer stopReload()
+	if tlsConf := s.config.GRPC.TLS; tlsConf.CertFile != "" || tlsConf.KeyFile != "" {
+		reloader, err := tlsconfig.NewReloader(tlsConf, s.log)
+		if err != nil {
+			s.log.Fatal(err)
+		}
+		go reloader.Run(reloadCtx)
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(reloader.Config())))
+	}
+	grpcSrv := grpc.NewServer(grpcOpts...)
 	grpcService := service.NewDvdstoreService(uc, s.log)
 	proto.RegisterDvdstoreServer(grpcSrv, grpcService)
 
+	// Health checks follow database availability
+	checker := health.NewChecker(ping, s.config.Health.CheckInterval, s.log,
+		proto.Dvdstore_ServiceDesc.ServiceName)
+	healthpb.RegisterHealthServer(grpcSrv, checker.Server())
+
+	checkCtx, stopChecks := context.WithCancel(context.Background())
+	defer stopChecks()
+	go checker.Run(checkCtx)
+
 	reflection.Register(grpcSrv)
 
 	// Create a TCP socket for inbound server connections
@@ -61,17 +135,107 @@ func (s *Server) Run() error {
 
 	// Start GRPC server and shutdown gracefully
 	go func() {
-		s.log.Infof("GRPC listening on port %v", grpcport)
+		s.log.Infof("GRPC listening on port %v, TLS: %v", grpcport, s.config.GRPC.TLS.CertFile != "")
 		s.log.Fatal(grpcSrv.Serve(ls))
 	}()
 
+	// New REST server with health probes and metrics
+	mux := http.NewServeMux()
+	mux.Handle("/", rest.NewDvdstoreHandler(uc, tokens, apiKeys, s.log))
+	mux.Handle("/healthz", checker.LivenessHandler())
+	mux.Handle("/readyz", checker.ReadinessHandler())
+	mux.Handle("/metrics", m.Handler())
+
+	httpport := s.config.HTTP.Port
+	httpSrv := &http.Server{
+		Addr:              fmt.Sprintf(":%v", httpport),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start REST server
+	go func() {
+		s.log.Infof("HTTP listening on port %v", httpport)
+		if err := httpSrv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			s.log.Fatal(err)
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	<-quit
 
+	// Report NOT_SERVING while active requests are finished
+	checker.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpSrv.Shutdown(ctx); err != nil {
+		s.log.Errorf("HTTP server shutdown: %v", err)
+	}
 	grpcSrv.GracefulStop()
 	s.log.Info("Server exited properly")
 
 	return nil
 }
+
+// newRepository returns repository of configured driver and ping of its data source.
+// Postgres schema is migrated and connection pool stats are added to metrics
+func (s *Server) newRepository(m *metrics.Metrics) (dvdstore.PostgresRepo, func(ctx context.Context) error, error) {
+	if s.config.Repository.InMemory() {
+		memRepo := memory.NewMemRepo(m)
+		if s.config.Repository.SampleData {
+			memRepo.AddSampleData()
+		}
+		s.log.Warn("Using memory repository, data is lost on exit")
+		return memRepo, func(context.Context) error { return nil }, nil
+	}
+
+	// Apply pending migrations if enabled and check that the schema is up to date
+	if err := s.migrateSchema(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	m.RegisterDB(s.dbConn, s.config.Postgres.DBName)
+
+	pgRepo, err := repo.NewPgRepo(s.dbConn, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	ping := func(ctx context.Context) error {
+		return postgres.Ping(ctx, s.dbConn, s.config.Health.Timeout)
+	}
+	return pgRepo, ping, nil
+}
+
+// migrateSchema applies pending schema migrations if auto migration is enabled and checks
+// schema version. Returns error if the database schema is older than the app expects. Newer
+// schema is allowed, so the previous app version keeps working while the new one is deployed
+func (s *Server) migrateSchema(ctx context.Context) error {
+	migrator, err := migrate.New(s.dbConn, schema.Migrations)
+	if err != nil {
+		return err
+	}
+
+	if s.config.Postgres.AutoMigrate {
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			s.log.Infof("Applied migration %v_%v", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	version, err := migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	switch latest := migrator.Latest(); {
+	case version < latest:
+		return fmt.Errorf("database schema version %v is older than %v, run \"migrate up\"", version, latest)
+	case version > latest:
+		s.log.Warnf("Database schema version %v is newer than %v", version, latest)
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lockKey is a key of postgres advisory lock held while migrating, so instances
+// started at the same time don't apply migrations twice
+const lockKey = 7301001
+
+const (
+	sqlCreateTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)
+	`
+	sqlApplied = `
+	SELECT version, applied_at FROM schema_migrations
+	`
+	sqlVersion = `
+	SELECT COALESCE(max(version), 0) FROM schema_migrations
+	`
+	sqlInsert = `
+	INSERT INTO schema_migrations (version, name) VALUES ($1, $2)
+	`
+	sqlDelete = `
+	DELETE FROM schema_migrations WHERE version = $1
+	`
+	sqlLock = `
+	SELECT pg_advisory_lock($1)
+	`
+	sqlUnlock = `
+	SELECT pg_advisory_unlock($1)
+	`
+)
+
+// fileName is a name of migration file: version, name and direction
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a versioned schema change with SQL applying and reverting it
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration with time it was applied, AppliedAt is nil for pending migration
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies migrations to postgres database and records applied versions
+// in schema_migrations table
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+}
+
+// New returns migrator of migrations read from fsys. Every migration must have both up
+// and down file, versions must be unique. Returns error if files are not valid
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := read(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("migrate.New: %v", err)
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// read returns migrations of fsys sorted by version
+func read(fsys fs.FS) ([]*Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, name := range names {
+		m := fileName.FindStringSubmatch(name)
+		if m == nil {
+			return nil, fmt.Errorf("%v is not a migration file", name)
+		}
+		version, err := strconv.Atoi(m[1])
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("%v has invalid version", name)
+		}
+		body, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("version %v is used by %v and %v", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %v_%v must have up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Latest returns version of the latest migration or 0 if there are no migrations
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the latest applied version or 0 if no migrations were applied
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	if _, err := m.db.ExecContext(ctx, sqlCreateTable); err != nil {
+		return 0, fmt.Errorf("Version sql.Exec: %v", err)
+	}
+	var version int
+	if err := m.db.QueryRowContext(ctx, sqlVersion).Scan(&version); err != nil {
+		return 0, fmt.Errorf("Version sql.QueryRow: %v", err)
+	}
+	return version, nil
+}
+
+// Status returns all migrations with times they were applied
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	var statuses []*Status
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			s := &Status{Migration: *mig}
+			if at, ok := applied[mig.Version]; ok {
+				s.AppliedAt = &at
+			}
+			statuses = append(statuses, s)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Up applies pending migrations in version order and returns them. Every migration is
+// applied in its own transaction, Up stops at the first failed one
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := apply(ctx, conn, mig.Up, sqlInsert, mig.Version, mig.Name); err != nil {
+				return fmt.Errorf("migration %v_%v up: %v", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts steps latest applied migrations in reverse version order and returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if err := apply(ctx, conn, mig.Down, sqlDelete, mig.Version); err != nil {
+				return fmt.Errorf("migration %v_%v down: %v", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline records migrations up to version as applied without running them. It is used
+// for databases that got these changes before migrations were tracked. Returns error if
+// some migrations are already recorded
+func (m *Migrator) Baseline(ctx context.Context, version int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			return fmt.Errorf("baseline: %v migrations are already applied", len(applied))
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, err := conn.ExecContext(ctx, sqlInsert, mig.Version, mig.Name); err != nil {
+				return fmt.Errorf("baseline sql.Exec: %v", err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// locked runs f on a connection holding migration lock. Migrations table is created
+// if it doesn't exist
+func (m *Migrator) locked(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("sql.Conn: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, sqlLock, lockKey); err != nil {
+		return fmt.Errorf("lock sql.Exec: %v", err)
+	}
+	defer conn.ExecContext(context.Background(), sqlUnlock, lockKey)
+
+	if _, err := conn.ExecContext(ctx, sqlCreateTable); err != nil {
+		return fmt.Errorf("create table sql.Exec: %v", err)
+	}
+	return f(conn)
+}
+
+// appliedVersions returns applied versions with times they were applied
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, sqlApplied)
+	if err != nil {
+		return nil, fmt.Errorf("applied sql.Query: %v", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("applied rows.Scan: %v", err)
+		}
+		applied[version] = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("applied rows.Err: %v", err)
+	}
+	return applied, nil
+}
+
+// apply runs migration SQL and records it with record query in one transaction
+func apply(ctx context.Context, conn *sql.Conn, migration, record string, args ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sql.BeginTx: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration); err != nil {
+		return fmt.Errorf("sql.Exec: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return fmt.Errorf("record sql.Exec: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("tx.Commit: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package migrate
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexzh7/sample-service/schema"
+)
+
+// testFS has two valid migrations
+var testFS = fstest.MapFS{
+	"002_add_index.up.sql":    {Data: []byte("CREATE INDEX ix ON t (c);")},
+	"002_add_index.down.sql":  {Data: []byte("DROP INDEX ix;")},
+	"001_add_column.up.sql":   {Data: []byte("ALTER TABLE t ADD COLUMN c INT;")},
+	"001_add_column.down.sql": {Data: []byte("ALTER TABLE t DROP COLUMN c;")},
+}
+
+func TestRead(t *testing.T) {
+	migrations, err := read(testFS)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, &Migration{Version: 1, Name: "add_column", Up: "ALTER TABLE t ADD COLUMN c INT;",
+		Down: "ALTER TABLE t DROP COLUMN c;"}, migrations[0])
+	assert.Equal(t, 2, migrations[1].Version)
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"bad name":  {"add_column.up.sql": {Data: []byte("SELECT 1")}},
+		"no down":   {"001_add_column.up.sql": {Data: []byte("SELECT 1")}},
+		"duplicate": {"001_a.up.sql": {Data: []byte("SELECT 1")}, "001_b.down.sql": {Data: []byte("SELECT 1")}},
+		"zero":      {"000_a.up.sql": {Data: []byte("SELECT 1")}, "000_a.down.sql": {Data: []byte("SELECT 1")}},
+	}
+	for name, fsys := range tests {
+		_, err := read(fsys)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSchemaMigrations(t *testing.T) {
+	m, err := New(nil, schema.Migrations)
+	require.NoError(t, err)
+	assert.Equal(t, len(m.migrations), m.Latest())
+}
+
+func TestUp(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`SELECT pg_advisory_lock`).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()))
+	mock.ExpectBegin()
+	mock.ExpectExec(`CREATE INDEX ix`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO schema_migrations`).WithArgs(2, "add_index").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(`SELECT pg_advisory_unlock`).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m, err := New(db, testFS)
+	require.NoError(t, err)
+	applied, err := m.Up(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, applied, 1)
+	assert.Equal(t, 2, applied[0].Version)
+}
+
+func TestDown(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`SELECT pg_advisory_lock`).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).
+			AddRow(1, time.Now()).AddRow(2, time.Now()))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DROP INDEX ix`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM schema_migrations`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(`SELECT pg_advisory_unlock`).WithArgs(lockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m, err := New(db, testFS)
+	require.NoError(t, err)
+	reverted, err := m.Down(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, reverted, 1)
+	assert.Equal(t, 2, reverted[0].Version)
+}
@@ -1,21 +1,27 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/alexzh7/sample-service/config"
 )
 
 // NewPostgresConn returns new connection to postgresql from passed config params
 func NewPostgresConn(c *config.Config) (*sql.DB, error) {
-	connStr := fmt.Sprintf("host=%v port=%v user=%v password=%v dbname=%v sslmode=disable",
+	connStr := fmt.Sprintf("host=%v port=%v user=%v password=%v dbname=%v sslmode=%v",
 		c.Postgres.Host,
 		c.Postgres.Port,
 		c.Postgres.User,
 		c.Postgres.Password,
 		c.Postgres.DBName,
+		c.Postgres.SSLMode,
 	)
+	if c.Postgres.SSLRootCert != "" {
+		connStr += fmt.Sprintf(" sslrootcert=%v", c.Postgres.SSLRootCert)
+	}
 
 	db, err := sql.Open("postgres", connStr)
 	if err != nil {
@@ -28,3 +34,14 @@ func NewPostgresConn(c *config.Config) (*sql.DB, error) {
 
 	return db, nil
 }
+
+// Ping checks that the database is reachable within timeout
+func Ping(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("postgres ping: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/alexzh7/sample-service/config"
+)
+
+// nextProtos are ALPN protocols of grpc. Config returned for a client replaces the server
+// config, so it must announce HTTP/2 itself
+var nextProtos = []string{"h2"}
+
+// Reloader keeps server certificate and client CAs loaded from files and reloads them
+// when files change, so rotated certificates are used without restart
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	interval     time.Duration
+	log          *zap.SugaredLogger
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	stamps    []fileStamp
+}
+
+// fileStamp identifies content version of a file
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader returns reloader of files set in passed config checked every ReloadInterval.
+// Returns error if certificate, key or client CAs can't be loaded
+func NewReloader(c config.TLSConfig, log *zap.SugaredLogger) (*Reloader, error) {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, errors.New("NewReloader: CertFile and KeyFile must be set")
+	}
+	if c.ReloadInterval <= 0 {
+		return nil, errors.New("NewReloader: ReloadInterval must be > 0")
+	}
+	r := &Reloader{
+		certFile:     c.CertFile,
+		keyFile:      c.KeyFile,
+		clientCAFile: c.ClientCAFile,
+		interval:     c.ReloadInterval,
+		log:          log,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, fmt.Errorf("NewReloader: %v", err)
+	}
+	return r, nil
+}
+
+// Config returns grpc server TLS config using the latest loaded certificate. Clients must
+// present certificate signed by client CAs if client CA file is set
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if r.clientCAs != nil {
+				c.ClientAuth = tls.RequireAndVerifyClientCert
+				c.ClientCAs = r.clientCAs
+			}
+			return c, nil
+		},
+	}
+}
+
+// Run checks files every interval until context is done and reloads them if they changed.
+// Old certificate is kept if new files can't be loaded
+func (r *Reloader) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				r.log.Errorf("TLS reload: %v", err)
+				continue
+			}
+			r.log.Infof("TLS certificate %v reloaded", r.certFile)
+		}
+	}
+}
+
+// Reload loads certificate, key and client CAs from files
+func (r *Reloader) Reload() error {
+	stamps, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("tls.LoadX509KeyPair: %v", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("os.ReadFile: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %v", r.clientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.clientCAs, r.stamps = &cert, clientCAs, stamps
+	return nil
+}
+
+// changed reports if any of the files changed since the last successful load
+func (r *Reloader) changed() bool {
+	stamps, err := r.stat()
+	if err != nil {
+		r.log.Debugf("TLS reload: %v", err)
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := range stamps {
+		if stamps[i] != r.stamps[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// stat returns stamps of the files
+func (r *Reloader) stat() ([]fileStamp, error) {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+
+	stamps := make([]fileStamp, 0, len(files))
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, fmt.Errorf("os.Stat: %v", err)
+		}
+		stamps = append(stamps, fileStamp{modTime: info.ModTime(), size: info.Size()})
+	}
+	return stamps, nil
+}
@@ -0,0 +1,157 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/alexzh7/sample-service/config"
+)
+
+// writeCert writes self-signed certificate with common name and its key to dir and
+// returns the certificate
+func writeCert(t *testing.T, dir, name string, modTime time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+// handshake connects client with passed config to server with passed config over loopback
+// and returns server's connection state
+func handshake(t *testing.T, server, client *tls.Config) (tls.ConnectionState, error) {
+	ls, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ls.Close()
+
+	errc := make(chan error, 1)
+	go func() {
+		conn, err := tls.Dial("tcp", ls.Addr().String(), client)
+		if err != nil {
+			errc <- err
+			return
+		}
+		defer conn.Close()
+		// Client certificate is rejected after client handshake in TLS 1.3, read the alert
+		_, err = conn.Read(make([]byte, 1))
+		errc <- err
+	}()
+
+	conn, err := ls.Accept()
+	require.NoError(t, err)
+	srv := tls.Server(conn, server)
+	err = srv.Handshake()
+	state := srv.ConnectionState()
+	srv.Close()
+	if clientErr := <-errc; err == nil && clientErr != io.EOF {
+		err = clientErr
+	}
+	return state, err
+}
+
+func TestNewReloader(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewReloader(config.TLSConfig{CertFile: filepath.Join(dir, "none.crt"),
+		KeyFile: filepath.Join(dir, "none.key"), ReloadInterval: time.Second}, zap.NewNop().Sugar())
+	assert.Error(t, err)
+
+	writeCert(t, dir, "server", time.Now())
+	_, err = NewReloader(config.TLSConfig{CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile: filepath.Join(dir, "server.key")}, zap.NewNop().Sugar())
+	assert.Error(t, err)
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	first := writeCert(t, dir, "server", time.Now().Add(-time.Minute))
+	r, err := NewReloader(config.TLSConfig{
+		CertFile:       filepath.Join(dir, "server.crt"),
+		KeyFile:        filepath.Join(dir, "server.key"),
+		ReloadInterval: time.Second,
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	assert.False(t, r.changed())
+
+	trusting := func(cert *x509.Certificate) *tls.Config {
+		pool := x509.NewCertPool()
+		pool.AddCert(cert)
+		return &tls.Config{RootCAs: pool, ServerName: "localhost"}
+	}
+	_, err = handshake(t, r.Config(), trusting(first))
+	assert.NoError(t, err)
+
+	second := writeCert(t, dir, "server", time.Now())
+	assert.True(t, r.changed())
+	require.NoError(t, r.Reload())
+	assert.False(t, r.changed())
+
+	_, err = handshake(t, r.Config(), trusting(first))
+	assert.Error(t, err)
+	_, err = handshake(t, r.Config(), trusting(second))
+	assert.NoError(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCert := writeCert(t, dir, "server", time.Now())
+	writeCert(t, dir, "reporting", time.Now())
+	r, err := NewReloader(config.TLSConfig{
+		CertFile:       filepath.Join(dir, "server.crt"),
+		KeyFile:        filepath.Join(dir, "server.key"),
+		ClientCAFile:   filepath.Join(dir, "reporting.crt"),
+		ReloadInterval: time.Second,
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(serverCert)
+	client := &tls.Config{RootCAs: pool, ServerName: "localhost"}
+
+	_, err = handshake(t, r.Config(), client)
+	assert.Error(t, err)
+
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "reporting.crt"), filepath.Join(dir, "reporting.key"))
+	require.NoError(t, err)
+	client.Certificates = []tls.Certificate{clientCert}
+	state, err := handshake(t, r.Config(), client)
+	require.NoError(t, err)
+	assert.Equal(t, "reporting", state.VerifiedChains[0][0].Subject.CommonName)
+}
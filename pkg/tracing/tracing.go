@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+
+	"github.com/alexzh7/sample-service/config"
+)
+
+// NewTracerProvider returns tracer provider of serviceName exporting spans as set in passed
+// config. Spans are not exported if exporter is "none", but trace context is still propagated
+func NewTracerProvider(c *config.Config, serviceName string) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.Tracing.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName))),
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "":
+	case "stdout":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("stdouttrace.New: %v", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	case "otlp":
+		clientOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.Tracing.Endpoint)}
+		if c.Tracing.Insecure {
+			clientOpts = append(clientOpts, otlptracehttp.WithInsecure())
+		}
+		exp, err := otlptracehttp.New(context.Background(), clientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("otlptracehttp.New: %v", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", c.Tracing.Exporter)
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OrderStatus is a status of the order lifecycle. New orders are pending
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PENDING     OrderStatus = 1
+	OrderStatus_ORDER_STATUS_PAID        OrderStatus = 2
+	OrderStatus_ORDER_STATUS_SHIPPED     OrderStatus = 3
+	OrderStatus_ORDER_STATUS_DELIVERED   OrderStatus = 4
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 5
+	OrderStatus_ORDER_STATUS_REFUNDED    OrderStatus = 6
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING",
+		2: "ORDER_STATUS_PAID",
+		3: "ORDER_STATUS_SHIPPED",
+		4: "ORDER_STATUS_DELIVERED",
+		5: "ORDER_STATUS_CANCELLED",
+		6: "ORDER_STATUS_REFUNDED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PENDING":     1,
+		"ORDER_STATUS_PAID":        2,
+		"ORDER_STATUS_SHIPPED":     3,
+		"ORDER_STATUS_DELIVERED":   4,
+		"ORDER_STATUS_CANCELLED":   5,
+		"ORDER_STATUS_REFUNDED":    6,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dvdstore_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_proto_dvdstore_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{0}
+}
+
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED  PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_CAPTURED    PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_VOIDED      PaymentStatus = 3
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_AUTHORIZED",
+		2: "PAYMENT_STATUS_CAPTURED",
+		3: "PAYMENT_STATUS_VOIDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_STATUS_AUTHORIZED":  1,
+		"PAYMENT_STATUS_CAPTURED":    2,
+		"PAYMENT_STATUS_VOIDED":      3,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dvdstore_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_proto_dvdstore_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{1}
+}
+
+// RefundStatus is a status of the return refund. Pending refunds are reconciled
+// with the payment provider
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNSPECIFIED RefundStatus = 0
+	RefundStatus_REFUND_STATUS_PENDING     RefundStatus = 1
+	RefundStatus_REFUND_STATUS_REFUNDED    RefundStatus = 2
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNSPECIFIED",
+		1: "REFUND_STATUS_PENDING",
+		2: "REFUND_STATUS_REFUNDED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNSPECIFIED": 0,
+		"REFUND_STATUS_PENDING":     1,
+		"REFUND_STATUS_REFUNDED":    2,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_dvdstore_proto_enumTypes[2].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_proto_dvdstore_proto_enumTypes[2]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{2}
+}
+
 type Customer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30,6 +196,22 @@ type Customer struct {
 	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
 	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
 	Age       int64  `protobuf:"varint,4,opt,name=Age,proto3" json:"Age,omitempty"`
+	Username  string `protobuf:"bytes,5,opt,name=Username,proto3" json:"Username,omitempty"`
+	Address1  string `protobuf:"bytes,6,opt,name=Address1,proto3" json:"Address1,omitempty"`
+	Address2  string `protobuf:"bytes,7,opt,name=Address2,proto3" json:"Address2,omitempty"`
+	City      string `protobuf:"bytes,8,opt,name=City,proto3" json:"City,omitempty"`
+	// State is required for US customers
+	State string `protobuf:"bytes,9,opt,name=State,proto3" json:"State,omitempty"`
+	// Zip is 5 or 5+4 digits for US customers, optional for other countries
+	Zip     string `protobuf:"bytes,10,opt,name=Zip,proto3" json:"Zip,omitempty"`
+	Country string `protobuf:"bytes,11,opt,name=Country,proto3" json:"Country,omitempty"`
+	// Region is derived from country: 1 for US, 2 for other countries. Ignored in requests
+	Region int64  `protobuf:"varint,12,opt,name=Region,proto3" json:"Region,omitempty"`
+	Email  string `protobuf:"bytes,13,opt,name=Email,proto3" json:"Email,omitempty"`
+	Phone  string `protobuf:"bytes,14,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Income int64  `protobuf:"varint,15,opt,name=Income,proto3" json:"Income,omitempty"`
+	// Gender is "M" or "F"
+	Gender string `protobuf:"bytes,16,opt,name=Gender,proto3" json:"Gender,omitempty"`
 }
 
 func (x *Customer) Reset() {
@@ -92,19 +274,104 @@ func (x *Customer) GetAge() int64 {
 	return 0
 }
 
-type Product struct {
+func (x *Customer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Customer) GetAddress1() string {
+	if x != nil {
+		return x.Address1
+	}
+	return ""
+}
+
+func (x *Customer) GetAddress2() string {
+	if x != nil {
+		return x.Address2
+	}
+	return ""
+}
+
+func (x *Customer) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Customer) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Customer) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+func (x *Customer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Customer) GetRegion() int64 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *Customer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Customer) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Customer) GetIncome() int64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *Customer) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+// Money is an amount of money in minor units of the currency,
+// e.g. {"Currency": "USD", "Amount": 2599} is $25.99. Empty currency
+// is treated as the store currency (USD)
+type Money struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64   `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Title    string  `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Price    float64 `protobuf:"fixed64,3,opt,name=Price,proto3" json:"Price,omitempty"`
-	Quantity int64   `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Currency string `protobuf:"bytes,1,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
+func (x *Money) Reset() {
+	*x = Money{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -112,13 +379,13 @@ func (x *Product) Reset() {
 	}
 }
 
-func (x *Product) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
+func (x *Money) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -130,54 +397,39 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Product) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Product) GetTitle() string {
+func (x *Money) GetCurrency() string {
 	if x != nil {
-		return x.Title
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *Product) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *Product) GetQuantity() int64 {
+func (x *Money) GetAmount() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.Amount
 	}
 	return 0
 }
 
-type Order struct {
+type Product struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Date,proto3" json:"Date,omitempty"`
-	NetAmount   float64                `protobuf:"fixed64,3,opt,name=NetAmount,proto3" json:"NetAmount,omitempty"`
-	Tax         float64                `protobuf:"fixed64,4,opt,name=Tax,proto3" json:"Tax,omitempty"`
-	TotalAmount float64                `protobuf:"fixed64,5,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`
-	ProductList []*Product             `protobuf:"bytes,6,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	Id       int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Quantity int64  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Price    *Money `protobuf:"bytes,5,opt,name=Price,proto3" json:"Price,omitempty"`
+	Category int64  `protobuf:"varint,6,opt,name=Category,proto3" json:"Category,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *Product) Reset() {
+	*x = Product{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -185,13 +437,13 @@ func (x *Order) Reset() {
 	}
 }
 
-func (x *Order) String() string {
+func (x *Product) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*Product) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
+func (x *Product) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,64 +455,58 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Order) GetId() int64 {
+func (x *Product) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Order) GetDate() *timestamppb.Timestamp {
+func (x *Product) GetTitle() string {
 	if x != nil {
-		return x.Date
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *Order) GetNetAmount() float64 {
+func (x *Product) GetQuantity() int64 {
 	if x != nil {
-		return x.NetAmount
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Order) GetTax() float64 {
+func (x *Product) GetPrice() *Money {
 	if x != nil {
-		return x.Tax
+		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetTotalAmount() float64 {
+func (x *Product) GetCategory() int64 {
 	if x != nil {
-		return x.TotalAmount
+		return x.Category
 	}
 	return 0
 }
 
-func (x *Order) GetProductList() []*Product {
-	if x != nil {
-		return x.ProductList
-	}
-	return nil
-}
-
-// GetCustomersReq contains Limit that defines the limit of customers to return
-type GetCustomersReq struct {
+// Category of products
+type Category struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit int64 `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Id   int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
-func (x *GetCustomersReq) Reset() {
-	*x = GetCustomersReq{}
+func (x *Category) Reset() {
+	*x = Category{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -268,13 +514,13 @@ func (x *GetCustomersReq) Reset() {
 	}
 }
 
-func (x *GetCustomersReq) String() string {
+func (x *Category) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersReq) ProtoMessage() {}
+func (*Category) ProtoMessage() {}
 
-func (x *GetCustomersReq) ProtoReflect() protoreflect.Message {
+func (x *Category) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,29 +532,52 @@ func (x *GetCustomersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersReq.ProtoReflect.Descriptor instead.
-func (*GetCustomersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetCustomersReq) GetLimit() int64 {
+func (x *Category) GetId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
 	return 0
 }
 
-// GetCustomersRes contains list of customers
-type GetCustomersRes struct {
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerList []*Customer `protobuf:"bytes,1,rep,name=CustomerList,proto3" json:"CustomerList,omitempty"`
+	Id          int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Date        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Date,proto3" json:"Date,omitempty"`
+	ProductList []*Product             `protobuf:"bytes,6,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	Status      OrderStatus            `protobuf:"varint,7,opt,name=Status,proto3,enum=proto.OrderStatus" json:"Status,omitempty"`
+	// TaxRate is a tax rate applied to the order, e.g. 0.0725
+	TaxRate     float64 `protobuf:"fixed64,8,opt,name=TaxRate,proto3" json:"TaxRate,omitempty"`
+	NetAmount   *Money  `protobuf:"bytes,9,opt,name=NetAmount,proto3" json:"NetAmount,omitempty"`
+	Tax         *Money  `protobuf:"bytes,10,opt,name=Tax,proto3" json:"Tax,omitempty"`
+	TotalAmount *Money  `protobuf:"bytes,11,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`
+	// PaymentMethodID is a payment method the order is paid with, 0 for orders
+	// placed before payment methods or with deleted payment method
+	PaymentMethodID int64 `protobuf:"varint,12,opt,name=PaymentMethodID,proto3" json:"PaymentMethodID,omitempty"`
+	// Payment is authorized on order placement, captured when the order is shipped
+	// and voided when it's cancelled. Not set for orders placed before payments
+	Payment *Payment `protobuf:"bytes,13,opt,name=Payment,proto3" json:"Payment,omitempty"`
+	// ReturnList are returns of the order products sorted by id. Order amounts and
+	// products are kept as ordered
+	ReturnList []*OrderReturn `protobuf:"bytes,14,rep,name=ReturnList,proto3" json:"ReturnList,omitempty"`
 }
 
-func (x *GetCustomersRes) Reset() {
-	*x = GetCustomersRes{}
+func (x *Order) Reset() {
+	*x = Order{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -316,13 +585,13 @@ func (x *GetCustomersRes) Reset() {
 	}
 }
 
-func (x *GetCustomersRes) String() string {
+func (x *Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersRes) ProtoMessage() {}
+func (*Order) ProtoMessage() {}
 
-func (x *GetCustomersRes) ProtoReflect() protoreflect.Message {
+func (x *Order) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,43 +603,121 @@ func (x *GetCustomersRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersRes.ProtoReflect.Descriptor instead.
-func (*GetCustomersRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetCustomersRes) GetCustomerList() []*Customer {
+func (x *Order) GetId() int64 {
 	if x != nil {
-		return x.CustomerList
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// GetCustomerReq contains customer id to get
-type GetCustomerReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+func (x *Order) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
 }
 
-func (x *GetCustomerReq) Reset() {
-	*x = GetCustomerReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dvdstore_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetProductList() []*Product {
+	if x != nil {
+		return x.ProductList
 	}
+	return nil
 }
 
-func (x *GetCustomerReq) String() string {
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *Order) GetNetAmount() *Money {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+func (x *Order) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Order) GetTotalAmount() *Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentMethodID() int64 {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return 0
+}
+
+func (x *Order) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Order) GetReturnList() []*OrderReturn {
+	if x != nil {
+		return x.ReturnList
+	}
+	return nil
+}
+
+// OrderReturn is a return of ordered products. ProductList has returned quantities
+// at order prices, amounts are refunded at the order tax rate
+type OrderReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	OrderID      int64                  `protobuf:"varint,2,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Date         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Date,proto3" json:"Date,omitempty"`
+	ProductList  []*Product             `protobuf:"bytes,4,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	NetAmount    *Money                 `protobuf:"bytes,5,opt,name=NetAmount,proto3" json:"NetAmount,omitempty"`
+	Tax          *Money                 `protobuf:"bytes,6,opt,name=Tax,proto3" json:"Tax,omitempty"`
+	TotalAmount  *Money                 `protobuf:"bytes,7,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`
+	RefundStatus RefundStatus           `protobuf:"varint,8,opt,name=RefundStatus,proto3,enum=proto.RefundStatus" json:"RefundStatus,omitempty"`
+}
+
+func (x *OrderReturn) Reset() {
+	*x = OrderReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderReturn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerReq) ProtoMessage() {}
+func (*OrderReturn) ProtoMessage() {}
 
-func (x *GetCustomerReq) ProtoReflect() protoreflect.Message {
+func (x *OrderReturn) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,29 +729,80 @@ func (x *GetCustomerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerReq.ProtoReflect.Descriptor instead.
-func (*GetCustomerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderReturn.ProtoReflect.Descriptor instead.
+func (*OrderReturn) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCustomerReq) GetCustomerID() int64 {
+func (x *OrderReturn) GetId() int64 {
 	if x != nil {
-		return x.CustomerID
+		return x.Id
 	}
 	return 0
 }
 
-// GetCustomerRes contains single customer
-type GetCustomerRes struct {
+func (x *OrderReturn) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetProductList() []*Product {
+	if x != nil {
+		return x.ProductList
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetNetAmount() *Money {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetTotalAmount() *Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetRefundStatus() RefundStatus {
+	if x != nil {
+		return x.RefundStatus
+	}
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
+}
+
+// Payment is a payment of the order by its payment method
+type Payment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Customer *Customer `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Id     int64         `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Amount *Money        `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Status PaymentStatus `protobuf:"varint,3,opt,name=Status,proto3,enum=proto.PaymentStatus" json:"Status,omitempty"`
 }
 
-func (x *GetCustomerRes) Reset() {
-	*x = GetCustomerRes{}
+func (x *Payment) Reset() {
+	*x = Payment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,13 +810,13 @@ func (x *GetCustomerRes) Reset() {
 	}
 }
 
-func (x *GetCustomerRes) String() string {
+func (x *Payment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerRes) ProtoMessage() {}
+func (*Payment) ProtoMessage() {}
 
-func (x *GetCustomerRes) ProtoReflect() protoreflect.Message {
+func (x *Payment) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,30 +828,47 @@ func (x *GetCustomerRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerRes.ProtoReflect.Descriptor instead.
-func (*GetCustomerRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetCustomerRes) GetCustomer() *Customer {
+func (x *Payment) GetId() int64 {
 	if x != nil {
-		return x.Customer
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-// AddCustomerReq contains single customer to add
-// Customer "Id" field is ignored
-type AddCustomerReq struct {
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+// Card is a payment card to register as a payment method. Number and Cvc are
+// passed to the card tokenizer and never stored
+type Card struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Customer *Customer `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Number   string `protobuf:"bytes,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	ExpMonth int64  `protobuf:"varint,2,opt,name=ExpMonth,proto3" json:"ExpMonth,omitempty"`
+	ExpYear  int64  `protobuf:"varint,3,opt,name=ExpYear,proto3" json:"ExpYear,omitempty"`
+	Cvc      string `protobuf:"bytes,4,opt,name=Cvc,proto3" json:"Cvc,omitempty"`
 }
 
-func (x *AddCustomerReq) Reset() {
-	*x = AddCustomerReq{}
+func (x *Card) Reset() {
+	*x = Card{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,13 +876,13 @@ func (x *AddCustomerReq) Reset() {
 	}
 }
 
-func (x *AddCustomerReq) String() string {
+func (x *Card) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCustomerReq) ProtoMessage() {}
+func (*Card) ProtoMessage() {}
 
-func (x *AddCustomerReq) ProtoReflect() protoreflect.Message {
+func (x *Card) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,29 +894,58 @@ func (x *AddCustomerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCustomerReq.ProtoReflect.Descriptor instead.
-func (*AddCustomerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AddCustomerReq) GetCustomer() *Customer {
+func (x *Card) GetNumber() string {
 	if x != nil {
-		return x.Customer
+		return x.Number
 	}
-	return nil
+	return ""
 }
 
-// AddCustomerRes contains added customer id
-type AddCustomerRes struct {
+func (x *Card) GetExpMonth() int64 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *Card) GetExpYear() int64 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *Card) GetCvc() string {
+	if x != nil {
+		return x.Cvc
+	}
+	return ""
+}
+
+// PaymentMethod is a registered payment card of the customer. Only the card brand,
+// last four digits and expiry are exposed
+type PaymentMethod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	Id         int64 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CustomerID int64 `protobuf:"varint,2,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	// Brand is "visa", "mastercard", "amex", "discover" or "unknown"
+	Brand     string                 `protobuf:"bytes,3,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	LastFour  string                 `protobuf:"bytes,4,opt,name=LastFour,proto3" json:"LastFour,omitempty"`
+	ExpMonth  int64                  `protobuf:"varint,5,opt,name=ExpMonth,proto3" json:"ExpMonth,omitempty"`
+	ExpYear   int64                  `protobuf:"varint,6,opt,name=ExpYear,proto3" json:"ExpYear,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 }
 
-func (x *AddCustomerRes) Reset() {
-	*x = AddCustomerRes{}
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,13 +953,13 @@ func (x *AddCustomerRes) Reset() {
 	}
 }
 
-func (x *AddCustomerRes) String() string {
+func (x *PaymentMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCustomerRes) ProtoMessage() {}
+func (*PaymentMethod) ProtoMessage() {}
 
-func (x *AddCustomerRes) ProtoReflect() protoreflect.Message {
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,29 +971,75 @@ func (x *AddCustomerRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCustomerRes.ProtoReflect.Descriptor instead.
-func (*AddCustomerRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AddCustomerRes) GetCustomerID() int64 {
+func (x *PaymentMethod) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetCustomerID() int64 {
 	if x != nil {
 		return x.CustomerID
 	}
 	return 0
 }
 
-// DeleteCustomerReq contains customer id to delete
-type DeleteCustomerReq struct {
+func (x *PaymentMethod) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetLastFour() string {
+	if x != nil {
+		return x.LastFour
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetExpMonth() int64 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetExpYear() int64 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CartItem is a product in the customer cart. Product has current title, price and
+// category, its Quantity is a quantity in stock. Available is false if there is not
+// enough of the product in stock for the cart quantity
+type CartItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	Product   *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Quantity  int64    `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Available bool     `protobuf:"varint,3,opt,name=Available,proto3" json:"Available,omitempty"`
 }
 
-func (x *DeleteCustomerReq) Reset() {
-	*x = DeleteCustomerReq{}
+func (x *CartItem) Reset() {
+	*x = CartItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,13 +1047,13 @@ func (x *DeleteCustomerReq) Reset() {
 	}
 }
 
-func (x *DeleteCustomerReq) String() string {
+func (x *CartItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerReq) ProtoMessage() {}
+func (*CartItem) ProtoMessage() {}
 
-func (x *DeleteCustomerReq) ProtoReflect() protoreflect.Message {
+func (x *CartItem) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,27 +1065,48 @@ func (x *DeleteCustomerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerReq.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteCustomerReq) GetCustomerID() int64 {
+func (x *CartItem) GetProduct() *Product {
 	if x != nil {
-		return x.CustomerID
+		return x.Product
+	}
+	return nil
+}
+
+func (x *CartItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
 	}
 	return 0
 }
 
-// DeleteCustomerRes returns only error
-type DeleteCustomerRes struct {
+func (x *CartItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Cart is a customer cart with items sorted by product id. NetAmount is a sum of
+// items at current prices, tax is calculated on checkout. ExpiresAt is not set for
+// empty cart
+type Cart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64                  `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ItemList   []*CartItem            `protobuf:"bytes,2,rep,name=ItemList,proto3" json:"ItemList,omitempty"`
+	NetAmount  *Money                 `protobuf:"bytes,3,opt,name=NetAmount,proto3" json:"NetAmount,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *DeleteCustomerRes) Reset() {
-	*x = DeleteCustomerRes{}
+func (x *Cart) Reset() {
+	*x = Cart{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,13 +1114,13 @@ func (x *DeleteCustomerRes) Reset() {
 	}
 }
 
-func (x *DeleteCustomerRes) String() string {
+func (x *Cart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerRes) ProtoMessage() {}
+func (*Cart) ProtoMessage() {}
 
-func (x *DeleteCustomerRes) ProtoReflect() protoreflect.Message {
+func (x *Cart) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,36 +1132,68 @@ func (x *DeleteCustomerRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerRes.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{10}
 }
 
-// GetProductsReq contains Limit that defines the limit of products to return
-type GetProductsReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Cart) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
 
-	Limit int64 `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+func (x *Cart) GetItemList() []*CartItem {
+	if x != nil {
+		return x.ItemList
+	}
+	return nil
 }
 
-func (x *GetProductsReq) Reset() {
-	*x = GetProductsReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_dvdstore_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Cart) GetNetAmount() *Money {
+	if x != nil {
+		return x.NetAmount
 	}
+	return nil
 }
 
-func (x *GetProductsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Cart) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (*GetProductsReq) ProtoMessage() {}
+// GetCustomersReq contains Limit that defines the limit of customers to return,
+// SortBy field ("id" or "last_name", "id" if empty) and PageToken of the page
+// to return. PageToken is empty for the first page
+type GetCustomersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetProductsReq) ProtoReflect() protoreflect.Message {
+	Limit     int64  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	SortBy    string `protobuf:"bytes,3,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+}
+
+func (x *GetCustomersReq) Reset() {
+	*x = GetCustomersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersReq) ProtoMessage() {}
+
+func (x *GetCustomersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,29 +1205,45 @@ func (x *GetProductsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductsReq.ProtoReflect.Descriptor instead.
-func (*GetProductsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCustomersReq.ProtoReflect.Descriptor instead.
+func (*GetCustomersReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetProductsReq) GetLimit() int64 {
+func (x *GetCustomersReq) GetLimit() int64 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-// GetProductsRes contains list of products
-type GetProductsRes struct {
+func (x *GetCustomersReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCustomersReq) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetCustomersRes contains list of customers and token of the next page.
+// NextPageToken is empty if there are no more customers
+type GetCustomersRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductList []*Product `protobuf:"bytes,1,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	CustomerList  []*Customer `protobuf:"bytes,1,rep,name=CustomerList,proto3" json:"CustomerList,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
 }
 
-func (x *GetProductsRes) Reset() {
-	*x = GetProductsRes{}
+func (x *GetCustomersRes) Reset() {
+	*x = GetCustomersRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +1251,13 @@ func (x *GetProductsRes) Reset() {
 	}
 }
 
-func (x *GetProductsRes) String() string {
+func (x *GetCustomersRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductsRes) ProtoMessage() {}
+func (*GetCustomersRes) ProtoMessage() {}
 
-func (x *GetProductsRes) ProtoReflect() protoreflect.Message {
+func (x *GetCustomersRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,29 +1269,36 @@ func (x *GetProductsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductsRes.ProtoReflect.Descriptor instead.
-func (*GetProductsRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCustomersRes.ProtoReflect.Descriptor instead.
+func (*GetCustomersRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetProductsRes) GetProductList() []*Product {
+func (x *GetCustomersRes) GetCustomerList() []*Customer {
 	if x != nil {
-		return x.ProductList
+		return x.CustomerList
 	}
 	return nil
 }
 
-// GetProductReq contains product id to get
-type GetProductReq struct {
+func (x *GetCustomersRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetCustomerReq contains customer id to get
+type GetCustomerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
 }
 
-func (x *GetProductReq) Reset() {
-	*x = GetProductReq{}
+func (x *GetCustomerReq) Reset() {
+	*x = GetCustomerReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +1306,13 @@ func (x *GetProductReq) Reset() {
 	}
 }
 
-func (x *GetProductReq) String() string {
+func (x *GetCustomerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductReq) ProtoMessage() {}
+func (*GetCustomerReq) ProtoMessage() {}
 
-func (x *GetProductReq) ProtoReflect() protoreflect.Message {
+func (x *GetCustomerReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,29 +1324,29 @@ func (x *GetProductReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductReq.ProtoReflect.Descriptor instead.
-func (*GetProductReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCustomerReq.ProtoReflect.Descriptor instead.
+func (*GetCustomerReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetProductReq) GetProductID() int64 {
+func (x *GetCustomerReq) GetCustomerID() int64 {
 	if x != nil {
-		return x.ProductID
+		return x.CustomerID
 	}
 	return 0
 }
 
-// GetProductRes contains single product
-type GetProductRes struct {
+// GetCustomerRes contains single customer
+type GetCustomerRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Customer *Customer `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
 }
 
-func (x *GetProductRes) Reset() {
-	*x = GetProductRes{}
+func (x *GetCustomerRes) Reset() {
+	*x = GetCustomerRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +1354,13 @@ func (x *GetProductRes) Reset() {
 	}
 }
 
-func (x *GetProductRes) String() string {
+func (x *GetCustomerRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRes) ProtoMessage() {}
+func (*GetCustomerRes) ProtoMessage() {}
 
-func (x *GetProductRes) ProtoReflect() protoreflect.Message {
+func (x *GetCustomerRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,30 +1372,31 @@ func (x *GetProductRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRes.ProtoReflect.Descriptor instead.
-func (*GetProductRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCustomerRes.ProtoReflect.Descriptor instead.
+func (*GetCustomerRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetProductRes) GetProduct() *Product {
+func (x *GetCustomerRes) GetCustomer() *Customer {
 	if x != nil {
-		return x.Product
+		return x.Customer
 	}
 	return nil
 }
 
-// AddProductReq contains product tot add
-// Product "Id" field is ignored
-type AddProductReq struct {
+// AddCustomerReq contains single customer to add and his password.
+// Customer "Id" field is ignored
+type AddCustomerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Customer *Customer `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Password string    `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
 }
 
-func (x *AddProductReq) Reset() {
-	*x = AddProductReq{}
+func (x *AddCustomerReq) Reset() {
+	*x = AddCustomerReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +1404,13 @@ func (x *AddProductReq) Reset() {
 	}
 }
 
-func (x *AddProductReq) String() string {
+func (x *AddCustomerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductReq) ProtoMessage() {}
+func (*AddCustomerReq) ProtoMessage() {}
 
-func (x *AddProductReq) ProtoReflect() protoreflect.Message {
+func (x *AddCustomerReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,29 +1422,36 @@ func (x *AddProductReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductReq.ProtoReflect.Descriptor instead.
-func (*AddProductReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCustomerReq.ProtoReflect.Descriptor instead.
+func (*AddCustomerReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AddProductReq) GetProduct() *Product {
+func (x *AddCustomerReq) GetCustomer() *Customer {
 	if x != nil {
-		return x.Product
+		return x.Customer
 	}
 	return nil
 }
 
-// AddProductRes contains added product id
-type AddProductRes struct {
+func (x *AddCustomerReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// AddCustomerRes contains added customer id
+type AddCustomerRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
 }
 
-func (x *AddProductRes) Reset() {
-	*x = AddProductRes{}
+func (x *AddCustomerRes) Reset() {
+	*x = AddCustomerRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -885,13 +1459,13 @@ func (x *AddProductRes) Reset() {
 	}
 }
 
-func (x *AddProductRes) String() string {
+func (x *AddCustomerRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductRes) ProtoMessage() {}
+func (*AddCustomerRes) ProtoMessage() {}
 
-func (x *AddProductRes) ProtoReflect() protoreflect.Message {
+func (x *AddCustomerRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,29 +1477,30 @@ func (x *AddProductRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductRes.ProtoReflect.Descriptor instead.
-func (*AddProductRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCustomerRes.ProtoReflect.Descriptor instead.
+func (*AddCustomerRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddProductRes) GetProductID() int64 {
+func (x *AddCustomerRes) GetCustomerID() int64 {
 	if x != nil {
-		return x.ProductID
+		return x.CustomerID
 	}
 	return 0
 }
 
-// DeleteProductReq contains product id to delete
-type DeleteProductReq struct {
+// LoginReq contains customer credentials
+type LoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
 }
 
-func (x *DeleteProductReq) Reset() {
-	*x = DeleteProductReq{}
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,13 +1508,13 @@ func (x *DeleteProductReq) Reset() {
 	}
 }
 
-func (x *DeleteProductReq) String() string {
+func (x *LoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductReq) ProtoMessage() {}
+func (*LoginReq) ProtoMessage() {}
 
-func (x *DeleteProductReq) ProtoReflect() protoreflect.Message {
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,27 +1526,37 @@ func (x *DeleteProductReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductReq.ProtoReflect.Descriptor instead.
-func (*DeleteProductReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteProductReq) GetProductID() int64 {
+func (x *LoginReq) GetUsername() string {
 	if x != nil {
-		return x.ProductID
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-// DeleteProductRes returns only error
-type DeleteProductRes struct {
+func (x *LoginReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// LoginRes contains signed customer token and its expiration time
+type LoginRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token     string                 `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
 }
 
-func (x *DeleteProductRes) Reset() {
-	*x = DeleteProductRes{}
+func (x *LoginRes) Reset() {
+	*x = LoginRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -979,13 +1564,13 @@ func (x *DeleteProductRes) Reset() {
 	}
 }
 
-func (x *DeleteProductRes) String() string {
+func (x *LoginRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRes) ProtoMessage() {}
+func (*LoginRes) ProtoMessage() {}
 
-func (x *DeleteProductRes) ProtoReflect() protoreflect.Message {
+func (x *LoginRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -997,22 +1582,40 @@ func (x *DeleteProductRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRes.ProtoReflect.Descriptor instead.
-func (*DeleteProductRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginRes.ProtoReflect.Descriptor instead.
+func (*LoginRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{18}
 }
 
-// GetOrderReq contains order id to get
-type GetOrderReq struct {
+func (x *LoginRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// UpdateCustomerReq contains customer to update and mask of fields to update.
+// Customer "Id" field defines customer to update. Mask paths are customer
+// field names, e.g. "FirstName". If UpdateMask is empty, only "FirstName",
+// "LastName" and "Age" are updated, profile fields must be listed in the mask
+type UpdateCustomerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Customer   *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
 }
 
-func (x *GetOrderReq) Reset() {
-	*x = GetOrderReq{}
+func (x *UpdateCustomerReq) Reset() {
+	*x = UpdateCustomerReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,13 +1623,13 @@ func (x *GetOrderReq) Reset() {
 	}
 }
 
-func (x *GetOrderReq) String() string {
+func (x *UpdateCustomerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderReq) ProtoMessage() {}
+func (*UpdateCustomerReq) ProtoMessage() {}
 
-func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateCustomerReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1038,29 +1641,36 @@ func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
-func (*GetOrderReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCustomerReq.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetOrderReq) GetOrderID() int64 {
+func (x *UpdateCustomerReq) GetCustomer() *Customer {
 	if x != nil {
-		return x.OrderID
+		return x.Customer
 	}
-	return 0
+	return nil
 }
 
-//GetOrderRes contains single order
-type GetOrderRes struct {
+func (x *UpdateCustomerReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateCustomerRes contains updated customer
+type UpdateCustomerRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order *Order `protobuf:"bytes,1,opt,name=Order,proto3" json:"Order,omitempty"`
+	Customer *Customer `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
 }
 
-func (x *GetOrderRes) Reset() {
-	*x = GetOrderRes{}
+func (x *UpdateCustomerRes) Reset() {
+	*x = UpdateCustomerRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1068,13 +1678,13 @@ func (x *GetOrderRes) Reset() {
 	}
 }
 
-func (x *GetOrderRes) String() string {
+func (x *UpdateCustomerRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRes) ProtoMessage() {}
+func (*UpdateCustomerRes) ProtoMessage() {}
 
-func (x *GetOrderRes) ProtoReflect() protoreflect.Message {
+func (x *UpdateCustomerRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,20 +1696,20 @@ func (x *GetOrderRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRes.ProtoReflect.Descriptor instead.
-func (*GetOrderRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCustomerRes.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetOrderRes) GetOrder() *Order {
+func (x *UpdateCustomerRes) GetCustomer() *Customer {
 	if x != nil {
-		return x.Order
+		return x.Customer
 	}
 	return nil
 }
 
-// GetCustomerOrdersReq contains customer id
-type GetCustomerOrdersReq struct {
+// DeleteCustomerReq contains customer id to delete
+type DeleteCustomerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1107,8 +1717,8 @@ type GetCustomerOrdersReq struct {
 	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
 }
 
-func (x *GetCustomerOrdersReq) Reset() {
-	*x = GetCustomerOrdersReq{}
+func (x *DeleteCustomerReq) Reset() {
+	*x = DeleteCustomerReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1116,13 +1726,13 @@ func (x *GetCustomerOrdersReq) Reset() {
 	}
 }
 
-func (x *GetCustomerOrdersReq) String() string {
+func (x *DeleteCustomerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerOrdersReq) ProtoMessage() {}
+func (*DeleteCustomerReq) ProtoMessage() {}
 
-func (x *GetCustomerOrdersReq) ProtoReflect() protoreflect.Message {
+func (x *DeleteCustomerReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,29 +1744,27 @@ func (x *GetCustomerOrdersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerOrdersReq.ProtoReflect.Descriptor instead.
-func (*GetCustomerOrdersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCustomerReq.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetCustomerOrdersReq) GetCustomerID() int64 {
+func (x *DeleteCustomerReq) GetCustomerID() int64 {
 	if x != nil {
 		return x.CustomerID
 	}
 	return 0
 }
 
-// GetCustomerOrdersRes contain list of customer orders
-type GetCustomerOrdersRes struct {
+// DeleteCustomerRes returns only error
+type DeleteCustomerRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	OrderList []*Order `protobuf:"bytes,1,rep,name=OrderList,proto3" json:"OrderList,omitempty"`
 }
 
-func (x *GetCustomerOrdersRes) Reset() {
-	*x = GetCustomerOrdersRes{}
+func (x *DeleteCustomerRes) Reset() {
+	*x = DeleteCustomerRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1164,13 +1772,13 @@ func (x *GetCustomerOrdersRes) Reset() {
 	}
 }
 
-func (x *GetCustomerOrdersRes) String() string {
+func (x *DeleteCustomerRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerOrdersRes) ProtoMessage() {}
+func (*DeleteCustomerRes) ProtoMessage() {}
 
-func (x *GetCustomerOrdersRes) ProtoReflect() protoreflect.Message {
+func (x *DeleteCustomerRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,31 +1790,26 @@ func (x *GetCustomerOrdersRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerOrdersRes.ProtoReflect.Descriptor instead.
-func (*GetCustomerOrdersRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCustomerRes.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetCustomerOrdersRes) GetOrderList() []*Order {
-	if x != nil {
-		return x.OrderList
-	}
-	return nil
-}
-
-// AddOrderReq contains customer id and list of products to make order.
-// "Title" and "Price" fields in ProductList are ignored
-type AddOrderReq struct {
+// GetProductsReq contains Limit that defines the limit of products to return,
+// SortBy field ("id", "title" or "price", "id" if empty) and PageToken of the page
+// to return. PageToken is empty for the first page
+type GetProductsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerID  int64      `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
-	ProductList []*Product `protobuf:"bytes,2,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	Limit     int64  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	SortBy    string `protobuf:"bytes,3,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
 }
 
-func (x *AddOrderReq) Reset() {
-	*x = AddOrderReq{}
+func (x *GetProductsReq) Reset() {
+	*x = GetProductsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,13 +1817,13 @@ func (x *AddOrderReq) Reset() {
 	}
 }
 
-func (x *AddOrderReq) String() string {
+func (x *GetProductsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrderReq) ProtoMessage() {}
+func (*GetProductsReq) ProtoMessage() {}
 
-func (x *AddOrderReq) ProtoReflect() protoreflect.Message {
+func (x *GetProductsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1232,36 +1835,45 @@ func (x *AddOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrderReq.ProtoReflect.Descriptor instead.
-func (*AddOrderReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProductsReq.ProtoReflect.Descriptor instead.
+func (*GetProductsReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AddOrderReq) GetCustomerID() int64 {
+func (x *GetProductsReq) GetLimit() int64 {
 	if x != nil {
-		return x.CustomerID
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *AddOrderReq) GetProductList() []*Product {
+func (x *GetProductsReq) GetPageToken() string {
 	if x != nil {
-		return x.ProductList
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-// AddOrderRes contains created order id
-type AddOrderRes struct {
+func (x *GetProductsReq) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetProductsRes contains list of products and token of the next page.
+// NextPageToken is empty if there are no more products
+type GetProductsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	ProductList   []*Product `protobuf:"bytes,1,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
 }
 
-func (x *AddOrderRes) Reset() {
-	*x = AddOrderRes{}
+func (x *GetProductsRes) Reset() {
+	*x = GetProductsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,13 +1881,13 @@ func (x *AddOrderRes) Reset() {
 	}
 }
 
-func (x *AddOrderRes) String() string {
+func (x *GetProductsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrderRes) ProtoMessage() {}
+func (*GetProductsRes) ProtoMessage() {}
 
-func (x *AddOrderRes) ProtoReflect() protoreflect.Message {
+func (x *GetProductsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,29 +1899,45 @@ func (x *AddOrderRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrderRes.ProtoReflect.Descriptor instead.
-func (*AddOrderRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProductsRes.ProtoReflect.Descriptor instead.
+func (*GetProductsRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddOrderRes) GetOrderID() int64 {
+func (x *GetProductsRes) GetProductList() []*Product {
 	if x != nil {
-		return x.OrderID
+		return x.ProductList
 	}
-	return 0
+	return nil
 }
 
-// DeleteOrderReq contains order id to delete
-type DeleteOrderReq struct {
+func (x *GetProductsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchProductsReq contains product search filters and requested page.
+// Query is searched in product title and actor, empty filters are not applied.
+// Limit, PageToken and SortBy have the same meaning as in GetProductsReq
+type SearchProductsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Query       string  `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Categories  []int64 `protobuf:"varint,2,rep,packed,name=Categories,proto3" json:"Categories,omitempty"`
+	MinPrice    *Money  `protobuf:"bytes,3,opt,name=MinPrice,proto3" json:"MinPrice,omitempty"`
+	MaxPrice    *Money  `protobuf:"bytes,4,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	InStockOnly bool    `protobuf:"varint,5,opt,name=InStockOnly,proto3" json:"InStockOnly,omitempty"`
+	Limit       int64   `protobuf:"varint,6,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	PageToken   string  `protobuf:"bytes,7,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	SortBy      string  `protobuf:"bytes,8,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
 }
 
-func (x *DeleteOrderReq) Reset() {
-	*x = DeleteOrderReq{}
+func (x *SearchProductsReq) Reset() {
+	*x = SearchProductsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,13 +1945,13 @@ func (x *DeleteOrderReq) Reset() {
 	}
 }
 
-func (x *DeleteOrderReq) String() string {
+func (x *SearchProductsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderReq) ProtoMessage() {}
+func (*SearchProductsReq) ProtoMessage() {}
 
-func (x *DeleteOrderReq) ProtoReflect() protoreflect.Message {
+func (x *SearchProductsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1335,27 +1963,80 @@ func (x *DeleteOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderReq.ProtoReflect.Descriptor instead.
-func (*DeleteOrderReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchProductsReq.ProtoReflect.Descriptor instead.
+func (*SearchProductsReq) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteOrderReq) GetOrderID() int64 {
+func (x *SearchProductsReq) GetQuery() string {
 	if x != nil {
-		return x.OrderID
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsReq) GetCategories() []int64 {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchProductsReq) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *SearchProductsReq) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *SearchProductsReq) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *SearchProductsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-// DeleteOrderRes returns only error
-type DeleteOrderRes struct {
+func (x *SearchProductsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchProductsReq) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// SearchProductsRes contains list of found products and token of the next page.
+// NextPageToken is empty if there are no more products
+type SearchProductsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProductList   []*Product `protobuf:"bytes,1,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
 }
 
-func (x *DeleteOrderRes) Reset() {
-	*x = DeleteOrderRes{}
+func (x *SearchProductsRes) Reset() {
+	*x = SearchProductsRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_dvdstore_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,13 +2044,13 @@ func (x *DeleteOrderRes) Reset() {
 	}
 }
 
-func (x *DeleteOrderRes) String() string {
+func (x *SearchProductsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderRes) ProtoMessage() {}
+func (*SearchProductsRes) ProtoMessage() {}
 
-func (x *DeleteOrderRes) ProtoReflect() protoreflect.Message {
+func (x *SearchProductsRes) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_dvdstore_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,267 +2062,3207 @@ func (x *DeleteOrderRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderRes.ProtoReflect.Descriptor instead.
-func (*DeleteOrderRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchProductsRes.ProtoReflect.Descriptor instead.
+func (*SearchProductsRes) Descriptor() ([]byte, []int) {
 	return file_proto_dvdstore_proto_rawDescGZIP(), []int{26}
 }
 
-var File_proto_dvdstore_proto protoreflect.FileDescriptor
+func (x *SearchProductsRes) GetProductList() []*Product {
+	if x != nil {
+		return x.ProductList
+	}
+	return nil
+}
 
-var file_proto_dvdstore_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x76, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66,
-	0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x41, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xcb, 0x01, 0x0a, 0x05, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x54, 0x61, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
-	0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x30, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
-	0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x42, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x30,
-	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x2d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22,
-	0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x12, 0x28, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x41, 0x64,
-	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x07, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e,
-	0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x42,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x5f, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18,
-	0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x32, 0xf2, 0x05, 0x0a, 0x08, 0x44,
-	0x76, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x38,
-	0x0a, 0x0a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12,
-	0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x32,
-	0x0a, 0x08, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x42,
-	0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x65, 0x78, 0x7a, 0x68, 0x37, 0x2f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SearchProductsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-var (
-	file_proto_dvdstore_proto_rawDescOnce sync.Once
-	file_proto_dvdstore_proto_rawDescData = file_proto_dvdstore_proto_rawDesc
-)
+// GetProductReq contains product id to get
+type GetProductReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_proto_dvdstore_proto_rawDescGZIP() []byte {
-	file_proto_dvdstore_proto_rawDescOnce.Do(func() {
-		file_proto_dvdstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_dvdstore_proto_rawDescData)
-	})
-	return file_proto_dvdstore_proto_rawDescData
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
 }
 
-var file_proto_dvdstore_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_proto_dvdstore_proto_goTypes = []interface{}{
-	(*Customer)(nil),              // 0: proto.Customer
-	(*Product)(nil),               // 1: proto.Product
-	(*Order)(nil),                 // 2: proto.Order
-	(*GetCustomersReq)(nil),       // 3: proto.GetCustomersReq
-	(*GetCustomersRes)(nil),       // 4: proto.GetCustomersRes
-	(*GetCustomerReq)(nil),        // 5: proto.GetCustomerReq
-	(*GetCustomerRes)(nil),        // 6: proto.GetCustomerRes
-	(*AddCustomerReq)(nil),        // 7: proto.AddCustomerReq
-	(*AddCustomerRes)(nil),        // 8: proto.AddCustomerRes
-	(*DeleteCustomerReq)(nil),     // 9: proto.DeleteCustomerReq
-	(*DeleteCustomerRes)(nil),     // 10: proto.DeleteCustomerRes
-	(*GetProductsReq)(nil),        // 11: proto.GetProductsReq
-	(*GetProductsRes)(nil),        // 12: proto.GetProductsRes
-	(*GetProductReq)(nil),         // 13: proto.GetProductReq
-	(*GetProductRes)(nil),         // 14: proto.GetProductRes
-	(*AddProductReq)(nil),         // 15: proto.AddProductReq
-	(*AddProductRes)(nil),         // 16: proto.AddProductRes
-	(*DeleteProductReq)(nil),      // 17: proto.DeleteProductReq
-	(*DeleteProductRes)(nil),      // 18: proto.DeleteProductRes
-	(*GetOrderReq)(nil),           // 19: proto.GetOrderReq
-	(*GetOrderRes)(nil),           // 20: proto.GetOrderRes
-	(*GetCustomerOrdersReq)(nil),  // 21: proto.GetCustomerOrdersReq
-	(*GetCustomerOrdersRes)(nil),  // 22: proto.GetCustomerOrdersRes
-	(*AddOrderReq)(nil),           // 23: proto.AddOrderReq
-	(*AddOrderRes)(nil),           // 24: proto.AddOrderRes
-	(*DeleteOrderReq)(nil),        // 25: proto.DeleteOrderReq
-	(*DeleteOrderRes)(nil),        // 26: proto.DeleteOrderRes
-	(*timestamppb.Timestamp)(nil), // 27: google.protobuf.Timestamp
+func (x *GetProductReq) Reset() {
+	*x = GetProductReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_proto_dvdstore_proto_depIdxs = []int32{
-	27, // 0: proto.Order.Date:type_name -> google.protobuf.Timestamp
-	1,  // 1: proto.Order.ProductList:type_name -> proto.Product
-	0,  // 2: proto.GetCustomersRes.CustomerList:type_name -> proto.Customer
-	0,  // 3: proto.GetCustomerRes.Customer:type_name -> proto.Customer
-	0,  // 4: proto.AddCustomerReq.Customer:type_name -> proto.Customer
-	1,  // 5: proto.GetProductsRes.ProductList:type_name -> proto.Product
-	1,  // 6: proto.GetProductRes.Product:type_name -> proto.Product
-	1,  // 7: proto.AddProductReq.Product:type_name -> proto.Product
-	2,  // 8: proto.GetOrderRes.Order:type_name -> proto.Order
-	2,  // 9: proto.GetCustomerOrdersRes.OrderList:type_name -> proto.Order
-	1,  // 10: proto.AddOrderReq.ProductList:type_name -> proto.Product
-	3,  // 11: proto.Dvdstore.GetCustomers:input_type -> proto.GetCustomersReq
-	5,  // 12: proto.Dvdstore.GetCustomer:input_type -> proto.GetCustomerReq
-	7,  // 13: proto.Dvdstore.AddCustomer:input_type -> proto.AddCustomerReq
-	9,  // 14: proto.Dvdstore.DeleteCustomer:input_type -> proto.DeleteCustomerReq
-	11, // 15: proto.Dvdstore.GetProducts:input_type -> proto.GetProductsReq
-	13, // 16: proto.Dvdstore.GetProduct:input_type -> proto.GetProductReq
-	15, // 17: proto.Dvdstore.AddProduct:input_type -> proto.AddProductReq
-	17, // 18: proto.Dvdstore.DeleteProduct:input_type -> proto.DeleteProductReq
-	19, // 19: proto.Dvdstore.GetOrder:input_type -> proto.GetOrderReq
-	21, // 20: proto.Dvdstore.GetCustomerOrders:input_type -> proto.GetCustomerOrdersReq
-	23, // 21: proto.Dvdstore.AddOrder:input_type -> proto.AddOrderReq
-	25, // 22: proto.Dvdstore.DeleteOrder:input_type -> proto.DeleteOrderReq
-	4,  // 23: proto.Dvdstore.GetCustomers:output_type -> proto.GetCustomersRes
-	6,  // 24: proto.Dvdstore.GetCustomer:output_type -> proto.GetCustomerRes
-	8,  // 25: proto.Dvdstore.AddCustomer:output_type -> proto.AddCustomerRes
-	10, // 26: proto.Dvdstore.DeleteCustomer:output_type -> proto.DeleteCustomerRes
-	12, // 27: proto.Dvdstore.GetProducts:output_type -> proto.GetProductsRes
-	14, // 28: proto.Dvdstore.GetProduct:output_type -> proto.GetProductRes
-	16, // 29: proto.Dvdstore.AddProduct:output_type -> proto.AddProductRes
-	18, // 30: proto.Dvdstore.DeleteProduct:output_type -> proto.DeleteProductRes
-	20, // 31: proto.Dvdstore.GetOrder:output_type -> proto.GetOrderRes
-	22, // 32: proto.Dvdstore.GetCustomerOrders:output_type -> proto.GetCustomerOrdersRes
-	24, // 33: proto.Dvdstore.AddOrder:output_type -> proto.AddOrderRes
-	26, // 34: proto.Dvdstore.DeleteOrder:output_type -> proto.DeleteOrderRes
-	23, // [23:35] is the sub-list for method output_type
-	11, // [11:23] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+func (x *GetProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_proto_dvdstore_proto_init() }
-func file_proto_dvdstore_proto_init() {
-	if File_proto_dvdstore_proto != nil {
-		return
+func (*GetProductReq) ProtoMessage() {}
+
+func (x *GetProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_dvdstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Customer); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductReq.ProtoReflect.Descriptor instead.
+func (*GetProductReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetProductReq) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+// GetProductRes contains single product
+type GetProductRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+}
+
+func (x *GetProductRes) Reset() {
+	*x = GetProductRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRes) ProtoMessage() {}
+
+func (x *GetProductRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRes.ProtoReflect.Descriptor instead.
+func (*GetProductRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetProductRes) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// AddProductReq contains product tot add
+// Product "Id" field is ignored, "Category" must be an existing category id
+type AddProductReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+}
+
+func (x *AddProductReq) Reset() {
+	*x = AddProductReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductReq) ProtoMessage() {}
+
+func (x *AddProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductReq.ProtoReflect.Descriptor instead.
+func (*AddProductReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddProductReq) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// AddProductRes contains added product id
+type AddProductRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+}
+
+func (x *AddProductRes) Reset() {
+	*x = AddProductRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProductRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRes) ProtoMessage() {}
+
+func (x *AddProductRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRes.ProtoReflect.Descriptor instead.
+func (*AddProductRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddProductRes) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+// UpdateProductReq contains product to update and mask of fields to update.
+// Product "Id" field defines product to update. Only "Title" and "Price" can be
+// updated, use AdjustInventory to change quantity. If UpdateMask is empty,
+// all updatable fields are updated
+type UpdateProductReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product    *Product               `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+}
+
+func (x *UpdateProductReq) Reset() {
+	*x = UpdateProductReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductReq) ProtoMessage() {}
+
+func (x *UpdateProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductReq.ProtoReflect.Descriptor instead.
+func (*UpdateProductReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateProductReq) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateProductReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateProductRes contains updated product
+type UpdateProductRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+}
+
+func (x *UpdateProductRes) Reset() {
+	*x = UpdateProductRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRes) ProtoMessage() {}
+
+func (x *UpdateProductRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRes.ProtoReflect.Descriptor instead.
+func (*UpdateProductRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateProductRes) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// AdjustInventoryReq contains product id and quantity delta. Positive delta
+// adds items to stock, negative delta removes them
+type AdjustInventoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Delta     int64 `protobuf:"varint,2,opt,name=Delta,proto3" json:"Delta,omitempty"`
+}
+
+func (x *AdjustInventoryReq) Reset() {
+	*x = AdjustInventoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustInventoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustInventoryReq) ProtoMessage() {}
+
+func (x *AdjustInventoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustInventoryReq.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdjustInventoryReq) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *AdjustInventoryReq) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// AdjustInventoryRes contains product with adjusted quantity
+type AdjustInventoryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *Product `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+}
+
+func (x *AdjustInventoryRes) Reset() {
+	*x = AdjustInventoryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustInventoryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustInventoryRes) ProtoMessage() {}
+
+func (x *AdjustInventoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustInventoryRes.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AdjustInventoryRes) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// DeleteProductReq contains product id to delete
+type DeleteProductReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+}
+
+func (x *DeleteProductReq) Reset() {
+	*x = DeleteProductReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductReq) ProtoMessage() {}
+
+func (x *DeleteProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductReq.ProtoReflect.Descriptor instead.
+func (*DeleteProductReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteProductReq) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+// DeleteProductRes returns only error
+type DeleteProductRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteProductRes) Reset() {
+	*x = DeleteProductRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRes) ProtoMessage() {}
+
+func (x *DeleteProductRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRes.ProtoReflect.Descriptor instead.
+func (*DeleteProductRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{36}
+}
+
+// ListCategoriesReq is empty request, all categories are returned
+type ListCategoriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCategoriesReq) Reset() {
+	*x = ListCategoriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesReq) ProtoMessage() {}
+
+func (x *ListCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesReq.ProtoReflect.Descriptor instead.
+func (*ListCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{37}
+}
+
+// ListCategoriesRes contains list of categories sorted by id
+type ListCategoriesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryList []*Category `protobuf:"bytes,1,rep,name=CategoryList,proto3" json:"CategoryList,omitempty"`
+}
+
+func (x *ListCategoriesRes) Reset() {
+	*x = ListCategoriesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRes) ProtoMessage() {}
+
+func (x *ListCategoriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRes.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListCategoriesRes) GetCategoryList() []*Category {
+	if x != nil {
+		return x.CategoryList
+	}
+	return nil
+}
+
+// AddCategoryReq contains category to add
+// Category "Id" field is ignored
+type AddCategoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category *Category `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+}
+
+func (x *AddCategoryReq) Reset() {
+	*x = AddCategoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryReq) ProtoMessage() {}
+
+func (x *AddCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryReq.ProtoReflect.Descriptor instead.
+func (*AddCategoryReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddCategoryReq) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// AddCategoryRes contains added category id
+type AddCategoryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryID int64 `protobuf:"varint,1,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+}
+
+func (x *AddCategoryRes) Reset() {
+	*x = AddCategoryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCategoryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCategoryRes) ProtoMessage() {}
+
+func (x *AddCategoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCategoryRes.ProtoReflect.Descriptor instead.
+func (*AddCategoryRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddCategoryRes) GetCategoryID() int64 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+// GetOrderReq contains order id to get
+type GetOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+}
+
+func (x *GetOrderReq) Reset() {
+	*x = GetOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReq) ProtoMessage() {}
+
+func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
+func (*GetOrderReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOrderReq) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+// GetOrderRes contains single order
+type GetOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=Order,proto3" json:"Order,omitempty"`
+}
+
+func (x *GetOrderRes) Reset() {
+	*x = GetOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRes) ProtoMessage() {}
+
+func (x *GetOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRes.ProtoReflect.Descriptor instead.
+func (*GetOrderRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOrderRes) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// GetCustomerOrdersReq contains customer id and optional list of statuses
+// to filter orders by. If Statuses is empty, orders in all statuses are returned.
+// Limit defines the limit of orders to return (100 if empty), SortBy is a sort
+// field ("id" or "date", "id" if empty) and PageToken is a token of the page
+// to return. PageToken is empty for the first page
+type GetCustomerOrdersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64         `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	Statuses   []OrderStatus `protobuf:"varint,2,rep,packed,name=Statuses,proto3,enum=proto.OrderStatus" json:"Statuses,omitempty"`
+	Limit      int64         `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	PageToken  string        `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	SortBy     string        `protobuf:"bytes,5,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+}
+
+func (x *GetCustomerOrdersReq) Reset() {
+	*x = GetCustomerOrdersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomerOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerOrdersReq) ProtoMessage() {}
+
+func (x *GetCustomerOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerOrdersReq.ProtoReflect.Descriptor instead.
+func (*GetCustomerOrdersReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCustomerOrdersReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *GetCustomerOrdersReq) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetCustomerOrdersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCustomerOrdersReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCustomerOrdersReq) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+// GetCustomerOrdersRes contain list of customer orders and token of the next page.
+// NextPageToken is empty if there are no more orders
+type GetCustomerOrdersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderList     []*Order `protobuf:"bytes,1,rep,name=OrderList,proto3" json:"OrderList,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *GetCustomerOrdersRes) Reset() {
+	*x = GetCustomerOrdersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomerOrdersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerOrdersRes) ProtoMessage() {}
+
+func (x *GetCustomerOrdersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerOrdersRes.ProtoReflect.Descriptor instead.
+func (*GetCustomerOrdersRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCustomerOrdersRes) GetOrderList() []*Order {
+	if x != nil {
+		return x.OrderList
+	}
+	return nil
+}
+
+func (x *GetCustomerOrdersRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddOrderReq contains customer id, list of products to make order and id of the
+// customer payment method. "Title" and "Price" fields in ProductList are ignored.
+// Optional IdempotencyKey identifies the request: a retry with the same key returns
+// the original order id
+type AddOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID      int64      `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ProductList     []*Product `protobuf:"bytes,2,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+	IdempotencyKey  string     `protobuf:"bytes,3,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	PaymentMethodID int64      `protobuf:"varint,4,opt,name=PaymentMethodID,proto3" json:"PaymentMethodID,omitempty"`
+}
+
+func (x *AddOrderReq) Reset() {
+	*x = AddOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderReq) ProtoMessage() {}
+
+func (x *AddOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrderReq.ProtoReflect.Descriptor instead.
+func (*AddOrderReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddOrderReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *AddOrderReq) GetProductList() []*Product {
+	if x != nil {
+		return x.ProductList
+	}
+	return nil
+}
+
+func (x *AddOrderReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AddOrderReq) GetPaymentMethodID() int64 {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return 0
+}
+
+// AddOrderRes contains created order id
+type AddOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+}
+
+func (x *AddOrderRes) Reset() {
+	*x = AddOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrderRes) ProtoMessage() {}
+
+func (x *AddOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrderRes.ProtoReflect.Descriptor instead.
+func (*AddOrderRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddOrderRes) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+// CancelOrderReq contains order id to cancel
+type CancelOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+}
+
+func (x *CancelOrderReq) Reset() {
+	*x = CancelOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderReq) ProtoMessage() {}
+
+func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderReq.ProtoReflect.Descriptor instead.
+func (*CancelOrderReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CancelOrderReq) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+// CancelOrderRes returns only error
+type CancelOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelOrderRes) Reset() {
+	*x = CancelOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRes) ProtoMessage() {}
+
+func (x *CancelOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRes.ProtoReflect.Descriptor instead.
+func (*CancelOrderRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{48}
+}
+
+// TransitionOrderReq contains order id and status to move order to
+type TransitionOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64       `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Status  OrderStatus `protobuf:"varint,2,opt,name=Status,proto3,enum=proto.OrderStatus" json:"Status,omitempty"`
+}
+
+func (x *TransitionOrderReq) Reset() {
+	*x = TransitionOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionOrderReq) ProtoMessage() {}
+
+func (x *TransitionOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionOrderReq.ProtoReflect.Descriptor instead.
+func (*TransitionOrderReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TransitionOrderReq) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *TransitionOrderReq) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+// TransitionOrderRes contains order in the new status
+type TransitionOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=Order,proto3" json:"Order,omitempty"`
+}
+
+func (x *TransitionOrderRes) Reset() {
+	*x = TransitionOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionOrderRes) ProtoMessage() {}
+
+func (x *TransitionOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionOrderRes.ProtoReflect.Descriptor instead.
+func (*TransitionOrderRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TransitionOrderRes) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// ReturnOrderLinesReq contains order id and returned products with their
+// quantities. Only "Id" and "Quantity" fields of products are used
+type ReturnOrderLinesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID     int64      `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	ProductList []*Product `protobuf:"bytes,2,rep,name=ProductList,proto3" json:"ProductList,omitempty"`
+}
+
+func (x *ReturnOrderLinesReq) Reset() {
+	*x = ReturnOrderLinesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnOrderLinesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderLinesReq) ProtoMessage() {}
+
+func (x *ReturnOrderLinesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderLinesReq.ProtoReflect.Descriptor instead.
+func (*ReturnOrderLinesReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReturnOrderLinesReq) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *ReturnOrderLinesReq) GetProductList() []*Product {
+	if x != nil {
+		return x.ProductList
+	}
+	return nil
+}
+
+// ReturnOrderLinesRes contains recorded return
+type ReturnOrderLinesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Return *OrderReturn `protobuf:"bytes,1,opt,name=Return,proto3" json:"Return,omitempty"`
+}
+
+func (x *ReturnOrderLinesRes) Reset() {
+	*x = ReturnOrderLinesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnOrderLinesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderLinesRes) ProtoMessage() {}
+
+func (x *ReturnOrderLinesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderLinesRes.ProtoReflect.Descriptor instead.
+func (*ReturnOrderLinesRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReturnOrderLinesRes) GetReturn() *OrderReturn {
+	if x != nil {
+		return x.Return
+	}
+	return nil
+}
+
+// DeleteOrderReq contains order id to delete
+type DeleteOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+}
+
+func (x *DeleteOrderReq) Reset() {
+	*x = DeleteOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderReq) ProtoMessage() {}
+
+func (x *DeleteOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderReq.ProtoReflect.Descriptor instead.
+func (*DeleteOrderReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteOrderReq) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+// DeleteOrderRes returns only error
+type DeleteOrderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteOrderRes) Reset() {
+	*x = DeleteOrderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderRes) ProtoMessage() {}
+
+func (x *DeleteOrderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderRes.ProtoReflect.Descriptor instead.
+func (*DeleteOrderRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{54}
+}
+
+// AddPaymentMethodReq contains customer id and card to register
+type AddPaymentMethodReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	Card       *Card `protobuf:"bytes,2,opt,name=Card,proto3" json:"Card,omitempty"`
+}
+
+func (x *AddPaymentMethodReq) Reset() {
+	*x = AddPaymentMethodReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPaymentMethodReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentMethodReq) ProtoMessage() {}
+
+func (x *AddPaymentMethodReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentMethodReq.ProtoReflect.Descriptor instead.
+func (*AddPaymentMethodReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddPaymentMethodReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *AddPaymentMethodReq) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// AddPaymentMethodRes contains registered payment method
+type AddPaymentMethodRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethod *PaymentMethod `protobuf:"bytes,1,opt,name=PaymentMethod,proto3" json:"PaymentMethod,omitempty"`
+}
+
+func (x *AddPaymentMethodRes) Reset() {
+	*x = AddPaymentMethodRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPaymentMethodRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentMethodRes) ProtoMessage() {}
+
+func (x *AddPaymentMethodRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentMethodRes.ProtoReflect.Descriptor instead.
+func (*AddPaymentMethodRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddPaymentMethodRes) GetPaymentMethod() *PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+// GetPaymentMethodsReq contains customer id to get payment methods of
+type GetPaymentMethodsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+}
+
+func (x *GetPaymentMethodsReq) Reset() {
+	*x = GetPaymentMethodsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentMethodsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentMethodsReq) ProtoMessage() {}
+
+func (x *GetPaymentMethodsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentMethodsReq.ProtoReflect.Descriptor instead.
+func (*GetPaymentMethodsReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPaymentMethodsReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+// GetPaymentMethodsRes contains list of customer payment methods
+type GetPaymentMethodsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethodList []*PaymentMethod `protobuf:"bytes,1,rep,name=PaymentMethodList,proto3" json:"PaymentMethodList,omitempty"`
+}
+
+func (x *GetPaymentMethodsRes) Reset() {
+	*x = GetPaymentMethodsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentMethodsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentMethodsRes) ProtoMessage() {}
+
+func (x *GetPaymentMethodsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentMethodsRes.ProtoReflect.Descriptor instead.
+func (*GetPaymentMethodsRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPaymentMethodsRes) GetPaymentMethodList() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethodList
+	}
+	return nil
+}
+
+// DeletePaymentMethodReq contains payment method id to delete
+type DeletePaymentMethodReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethodID int64 `protobuf:"varint,1,opt,name=PaymentMethodID,proto3" json:"PaymentMethodID,omitempty"`
+}
+
+func (x *DeletePaymentMethodReq) Reset() {
+	*x = DeletePaymentMethodReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePaymentMethodReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentMethodReq) ProtoMessage() {}
+
+func (x *DeletePaymentMethodReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentMethodReq.ProtoReflect.Descriptor instead.
+func (*DeletePaymentMethodReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletePaymentMethodReq) GetPaymentMethodID() int64 {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return 0
+}
+
+// DeletePaymentMethodRes returns only error
+type DeletePaymentMethodRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePaymentMethodRes) Reset() {
+	*x = DeletePaymentMethodRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePaymentMethodRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentMethodRes) ProtoMessage() {}
+
+func (x *DeletePaymentMethodRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentMethodRes.ProtoReflect.Descriptor instead.
+func (*DeletePaymentMethodRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{60}
+}
+
+// GetCartReq contains customer id to get cart of
+type GetCartReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+}
+
+func (x *GetCartReq) Reset() {
+	*x = GetCartReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartReq) ProtoMessage() {}
+
+func (x *GetCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartReq.ProtoReflect.Descriptor instead.
+func (*GetCartReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCartReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+// GetCartRes contains customer cart
+type GetCartRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cart *Cart `protobuf:"bytes,1,opt,name=Cart,proto3" json:"Cart,omitempty"`
+}
+
+func (x *GetCartRes) Reset() {
+	*x = GetCartRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCartRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRes) ProtoMessage() {}
+
+func (x *GetCartRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRes.ProtoReflect.Descriptor instead.
+func (*GetCartRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCartRes) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// AddToCartReq contains customer id, product id and quantity to add
+type AddToCartReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ProductID  int64 `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Quantity   int64 `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+}
+
+func (x *AddToCartReq) Reset() {
+	*x = AddToCartReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddToCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCartReq) ProtoMessage() {}
+
+func (x *AddToCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCartReq.ProtoReflect.Descriptor instead.
+func (*AddToCartReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AddToCartReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *AddToCartReq) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *AddToCartReq) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// AddToCartRes contains updated cart
+type AddToCartRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cart *Cart `protobuf:"bytes,1,opt,name=Cart,proto3" json:"Cart,omitempty"`
+}
+
+func (x *AddToCartRes) Reset() {
+	*x = AddToCartRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddToCartRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCartRes) ProtoMessage() {}
+
+func (x *AddToCartRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCartRes.ProtoReflect.Descriptor instead.
+func (*AddToCartRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddToCartRes) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// RemoveFromCartReq contains customer id and product id to remove
+type RemoveFromCartReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID int64 `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	ProductID  int64 `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+}
+
+func (x *RemoveFromCartReq) Reset() {
+	*x = RemoveFromCartReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCartReq) ProtoMessage() {}
+
+func (x *RemoveFromCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCartReq.ProtoReflect.Descriptor instead.
+func (*RemoveFromCartReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveFromCartReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *RemoveFromCartReq) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+// RemoveFromCartRes contains updated cart
+type RemoveFromCartRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cart *Cart `protobuf:"bytes,1,opt,name=Cart,proto3" json:"Cart,omitempty"`
+}
+
+func (x *RemoveFromCartRes) Reset() {
+	*x = RemoveFromCartRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromCartRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCartRes) ProtoMessage() {}
+
+func (x *RemoveFromCartRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCartRes.ProtoReflect.Descriptor instead.
+func (*RemoveFromCartRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveFromCartRes) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// CheckoutReq contains customer id, payment method to pay with and optional
+// IdempotencyKey: a retry with the same key returns the original order
+type CheckoutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerID      int64  `protobuf:"varint,1,opt,name=CustomerID,proto3" json:"CustomerID,omitempty"`
+	PaymentMethodID int64  `protobuf:"varint,2,opt,name=PaymentMethodID,proto3" json:"PaymentMethodID,omitempty"`
+	IdempotencyKey  string `protobuf:"bytes,3,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+}
+
+func (x *CheckoutReq) Reset() {
+	*x = CheckoutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutReq) ProtoMessage() {}
+
+func (x *CheckoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutReq.ProtoReflect.Descriptor instead.
+func (*CheckoutReq) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CheckoutReq) GetCustomerID() int64 {
+	if x != nil {
+		return x.CustomerID
+	}
+	return 0
+}
+
+func (x *CheckoutReq) GetPaymentMethodID() int64 {
+	if x != nil {
+		return x.PaymentMethodID
+	}
+	return 0
+}
+
+func (x *CheckoutReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// CheckoutRes contains created order
+type CheckoutRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=Order,proto3" json:"Order,omitempty"`
+}
+
+func (x *CheckoutRes) Reset() {
+	*x = CheckoutRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dvdstore_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRes) ProtoMessage() {}
+
+func (x *CheckoutRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dvdstore_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRes.ProtoReflect.Descriptor instead.
+func (*CheckoutRes) Descriptor() ([]byte, []int) {
+	return file_proto_dvdstore_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CheckoutRes) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+var File_proto_dvdstore_proto protoreflect.FileDescriptor
+
+var file_proto_dvdstore_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x76, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x84, 0x03, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x41, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x31, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0x12, 0x12, 0x0a,
+	0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x5a, 0x69, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5a, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x2e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd5, 0x03, 0x0a, 0x05, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x4e, 0x65,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x4e, 0x65, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x03, 0x54, 0x61, 0x78, 0x12, 0x2e, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44,
+	0x12, 0x28, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x52, 0x0a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x22, 0xce, 0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
+	0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09,
+	0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x4e,
+	0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x03, 0x54, 0x61, 0x78, 0x12, 0x2e, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x6d, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x66, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x45, 0x78, 0x70, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45,
+	0x78, 0x70, 0x59, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x76, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x76, 0x63, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x78, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x45, 0x78, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x78, 0x70, 0x59, 0x65,
+	0x61, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x08,
+	0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xb9, 0x01, 0x0a,
+	0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x09, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x59, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x30, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5a, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x7c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x22, 0x40, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x5c, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x68, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x4d,
+	0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x4d, 0x61, 0x78,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x6e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x22, 0x6b, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x2d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22,
+	0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x22, 0x78, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x3c,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x48, 0x0a, 0x12,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x3e, 0x0a, 0x12, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x22, 0x48, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a,
+	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x30, 0x0a, 0x0e, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x2e, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x68, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x0b, 0x41,
+	0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x10, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x2a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38,
+	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x2a,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x13,
+	0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04,
+	0x43, 0x61, 0x72, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x36, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x5a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x04, 0x43, 0x61, 0x72, 0x74, 0x22, 0x68, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x2f, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x04, 0x43, 0x61, 0x72,
+	0x74, 0x22, 0x51, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x43, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x04, 0x43, 0x61, 0x72, 0x74, 0x22, 0x7f, 0x0a, 0x0b, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x0b, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2a, 0xc9,
+	0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x48,
+	0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x86, 0x01, 0x0a, 0x0d, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41,
+	0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x32, 0xdc, 0x0e, 0x0a, 0x08, 0x44, 0x76,
+	0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x41,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0f, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x10, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x53, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f,
+	0x43, 0x61, 0x72, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x12, 0x44,
+	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x78, 0x7a, 0x68, 0x37, 0x2f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_dvdstore_proto_rawDescOnce sync.Once
+	file_proto_dvdstore_proto_rawDescData = file_proto_dvdstore_proto_rawDesc
+)
+
+func file_proto_dvdstore_proto_rawDescGZIP() []byte {
+	file_proto_dvdstore_proto_rawDescOnce.Do(func() {
+		file_proto_dvdstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_dvdstore_proto_rawDescData)
+	})
+	return file_proto_dvdstore_proto_rawDescData
+}
+
+var file_proto_dvdstore_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_dvdstore_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_proto_dvdstore_proto_goTypes = []interface{}{
+	(OrderStatus)(0),               // 0: proto.OrderStatus
+	(PaymentStatus)(0),             // 1: proto.PaymentStatus
+	(RefundStatus)(0),              // 2: proto.RefundStatus
+	(*Customer)(nil),               // 3: proto.Customer
+	(*Money)(nil),                  // 4: proto.Money
+	(*Product)(nil),                // 5: proto.Product
+	(*Category)(nil),               // 6: proto.Category
+	(*Order)(nil),                  // 7: proto.Order
+	(*OrderReturn)(nil),            // 8: proto.OrderReturn
+	(*Payment)(nil),                // 9: proto.Payment
+	(*Card)(nil),                   // 10: proto.Card
+	(*PaymentMethod)(nil),          // 11: proto.PaymentMethod
+	(*CartItem)(nil),               // 12: proto.CartItem
+	(*Cart)(nil),                   // 13: proto.Cart
+	(*GetCustomersReq)(nil),        // 14: proto.GetCustomersReq
+	(*GetCustomersRes)(nil),        // 15: proto.GetCustomersRes
+	(*GetCustomerReq)(nil),         // 16: proto.GetCustomerReq
+	(*GetCustomerRes)(nil),         // 17: proto.GetCustomerRes
+	(*AddCustomerReq)(nil),         // 18: proto.AddCustomerReq
+	(*AddCustomerRes)(nil),         // 19: proto.AddCustomerRes
+	(*LoginReq)(nil),               // 20: proto.LoginReq
+	(*LoginRes)(nil),               // 21: proto.LoginRes
+	(*UpdateCustomerReq)(nil),      // 22: proto.UpdateCustomerReq
+	(*UpdateCustomerRes)(nil),      // 23: proto.UpdateCustomerRes
+	(*DeleteCustomerReq)(nil),      // 24: proto.DeleteCustomerReq
+	(*DeleteCustomerRes)(nil),      // 25: proto.DeleteCustomerRes
+	(*GetProductsReq)(nil),         // 26: proto.GetProductsReq
+	(*GetProductsRes)(nil),         // 27: proto.GetProductsRes
+	(*SearchProductsReq)(nil),      // 28: proto.SearchProductsReq
+	(*SearchProductsRes)(nil),      // 29: proto.SearchProductsRes
+	(*GetProductReq)(nil),          // 30: proto.GetProductReq
+	(*GetProductRes)(nil),          // 31: proto.GetProductRes
+	(*AddProductReq)(nil),          // 32: proto.AddProductReq
+	(*AddProductRes)(nil),          // 33: proto.AddProductRes
+	(*UpdateProductReq)(nil),       // 34: proto.UpdateProductReq
+	(*UpdateProductRes)(nil),       // 35: proto.UpdateProductRes
+	(*AdjustInventoryReq)(nil),     // 36: proto.AdjustInventoryReq
+	(*AdjustInventoryRes)(nil),     // 37: proto.AdjustInventoryRes
+	(*DeleteProductReq)(nil),       // 38: proto.DeleteProductReq
+	(*DeleteProductRes)(nil),       // 39: proto.DeleteProductRes
+	(*ListCategoriesReq)(nil),      // 40: proto.ListCategoriesReq
+	(*ListCategoriesRes)(nil),      // 41: proto.ListCategoriesRes
+	(*AddCategoryReq)(nil),         // 42: proto.AddCategoryReq
+	(*AddCategoryRes)(nil),         // 43: proto.AddCategoryRes
+	(*GetOrderReq)(nil),            // 44: proto.GetOrderReq
+	(*GetOrderRes)(nil),            // 45: proto.GetOrderRes
+	(*GetCustomerOrdersReq)(nil),   // 46: proto.GetCustomerOrdersReq
+	(*GetCustomerOrdersRes)(nil),   // 47: proto.GetCustomerOrdersRes
+	(*AddOrderReq)(nil),            // 48: proto.AddOrderReq
+	(*AddOrderRes)(nil),            // 49: proto.AddOrderRes
+	(*CancelOrderReq)(nil),         // 50: proto.CancelOrderReq
+	(*CancelOrderRes)(nil),         // 51: proto.CancelOrderRes
+	(*TransitionOrderReq)(nil),     // 52: proto.TransitionOrderReq
+	(*TransitionOrderRes)(nil),     // 53: proto.TransitionOrderRes
+	(*ReturnOrderLinesReq)(nil),    // 54: proto.ReturnOrderLinesReq
+	(*ReturnOrderLinesRes)(nil),    // 55: proto.ReturnOrderLinesRes
+	(*DeleteOrderReq)(nil),         // 56: proto.DeleteOrderReq
+	(*DeleteOrderRes)(nil),         // 57: proto.DeleteOrderRes
+	(*AddPaymentMethodReq)(nil),    // 58: proto.AddPaymentMethodReq
+	(*AddPaymentMethodRes)(nil),    // 59: proto.AddPaymentMethodRes
+	(*GetPaymentMethodsReq)(nil),   // 60: proto.GetPaymentMethodsReq
+	(*GetPaymentMethodsRes)(nil),   // 61: proto.GetPaymentMethodsRes
+	(*DeletePaymentMethodReq)(nil), // 62: proto.DeletePaymentMethodReq
+	(*DeletePaymentMethodRes)(nil), // 63: proto.DeletePaymentMethodRes
+	(*GetCartReq)(nil),             // 64: proto.GetCartReq
+	(*GetCartRes)(nil),             // 65: proto.GetCartRes
+	(*AddToCartReq)(nil),           // 66: proto.AddToCartReq
+	(*AddToCartRes)(nil),           // 67: proto.AddToCartRes
+	(*RemoveFromCartReq)(nil),      // 68: proto.RemoveFromCartReq
+	(*RemoveFromCartRes)(nil),      // 69: proto.RemoveFromCartRes
+	(*CheckoutReq)(nil),            // 70: proto.CheckoutReq
+	(*CheckoutRes)(nil),            // 71: proto.CheckoutRes
+	(*timestamppb.Timestamp)(nil),  // 72: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),  // 73: google.protobuf.FieldMask
+}
+var file_proto_dvdstore_proto_depIdxs = []int32{
+	4,  // 0: proto.Product.Price:type_name -> proto.Money
+	72, // 1: proto.Order.Date:type_name -> google.protobuf.Timestamp
+	5,  // 2: proto.Order.ProductList:type_name -> proto.Product
+	0,  // 3: proto.Order.Status:type_name -> proto.OrderStatus
+	4,  // 4: proto.Order.NetAmount:type_name -> proto.Money
+	4,  // 5: proto.Order.Tax:type_name -> proto.Money
+	4,  // 6: proto.Order.TotalAmount:type_name -> proto.Money
+	9,  // 7: proto.Order.Payment:type_name -> proto.Payment
+	8,  // 8: proto.Order.ReturnList:type_name -> proto.OrderReturn
+	72, // 9: proto.OrderReturn.Date:type_name -> google.protobuf.Timestamp
+	5,  // 10: proto.OrderReturn.ProductList:type_name -> proto.Product
+	4,  // 11: proto.OrderReturn.NetAmount:type_name -> proto.Money
+	4,  // 12: proto.OrderReturn.Tax:type_name -> proto.Money
+	4,  // 13: proto.OrderReturn.TotalAmount:type_name -> proto.Money
+	2,  // 14: proto.OrderReturn.RefundStatus:type_name -> proto.RefundStatus
+	4,  // 15: proto.Payment.Amount:type_name -> proto.Money
+	1,  // 16: proto.Payment.Status:type_name -> proto.PaymentStatus
+	72, // 17: proto.PaymentMethod.CreatedAt:type_name -> google.protobuf.Timestamp
+	5,  // 18: proto.CartItem.Product:type_name -> proto.Product
+	12, // 19: proto.Cart.ItemList:type_name -> proto.CartItem
+	4,  // 20: proto.Cart.NetAmount:type_name -> proto.Money
+	72, // 21: proto.Cart.ExpiresAt:type_name -> google.protobuf.Timestamp
+	3,  // 22: proto.GetCustomersRes.CustomerList:type_name -> proto.Customer
+	3,  // 23: proto.GetCustomerRes.Customer:type_name -> proto.Customer
+	3,  // 24: proto.AddCustomerReq.Customer:type_name -> proto.Customer
+	72, // 25: proto.LoginRes.ExpiresAt:type_name -> google.protobuf.Timestamp
+	3,  // 26: proto.UpdateCustomerReq.Customer:type_name -> proto.Customer
+	73, // 27: proto.UpdateCustomerReq.UpdateMask:type_name -> google.protobuf.FieldMask
+	3,  // 28: proto.UpdateCustomerRes.Customer:type_name -> proto.Customer
+	5,  // 29: proto.GetProductsRes.ProductList:type_name -> proto.Product
+	4,  // 30: proto.SearchProductsReq.MinPrice:type_name -> proto.Money
+	4,  // 31: proto.SearchProductsReq.MaxPrice:type_name -> proto.Money
+	5,  // 32: proto.SearchProductsRes.ProductList:type_name -> proto.Product
+	5,  // 33: proto.GetProductRes.Product:type_name -> proto.Product
+	5,  // 34: proto.AddProductReq.Product:type_name -> proto.Product
+	5,  // 35: proto.UpdateProductReq.Product:type_name -> proto.Product
+	73, // 36: proto.UpdateProductReq.UpdateMask:type_name -> google.protobuf.FieldMask
+	5,  // 37: proto.UpdateProductRes.Product:type_name -> proto.Product
+	5,  // 38: proto.AdjustInventoryRes.Product:type_name -> proto.Product
+	6,  // 39: proto.ListCategoriesRes.CategoryList:type_name -> proto.Category
+	6,  // 40: proto.AddCategoryReq.Category:type_name -> proto.Category
+	7,  // 41: proto.GetOrderRes.Order:type_name -> proto.Order
+	0,  // 42: proto.GetCustomerOrdersReq.Statuses:type_name -> proto.OrderStatus
+	7,  // 43: proto.GetCustomerOrdersRes.OrderList:type_name -> proto.Order
+	5,  // 44: proto.AddOrderReq.ProductList:type_name -> proto.Product
+	0,  // 45: proto.TransitionOrderReq.Status:type_name -> proto.OrderStatus
+	7,  // 46: proto.TransitionOrderRes.Order:type_name -> proto.Order
+	5,  // 47: proto.ReturnOrderLinesReq.ProductList:type_name -> proto.Product
+	8,  // 48: proto.ReturnOrderLinesRes.Return:type_name -> proto.OrderReturn
+	10, // 49: proto.AddPaymentMethodReq.Card:type_name -> proto.Card
+	11, // 50: proto.AddPaymentMethodRes.PaymentMethod:type_name -> proto.PaymentMethod
+	11, // 51: proto.GetPaymentMethodsRes.PaymentMethodList:type_name -> proto.PaymentMethod
+	13, // 52: proto.GetCartRes.Cart:type_name -> proto.Cart
+	13, // 53: proto.AddToCartRes.Cart:type_name -> proto.Cart
+	13, // 54: proto.RemoveFromCartRes.Cart:type_name -> proto.Cart
+	7,  // 55: proto.CheckoutRes.Order:type_name -> proto.Order
+	14, // 56: proto.Dvdstore.GetCustomers:input_type -> proto.GetCustomersReq
+	16, // 57: proto.Dvdstore.GetCustomer:input_type -> proto.GetCustomerReq
+	18, // 58: proto.Dvdstore.AddCustomer:input_type -> proto.AddCustomerReq
+	20, // 59: proto.Dvdstore.Login:input_type -> proto.LoginReq
+	22, // 60: proto.Dvdstore.UpdateCustomer:input_type -> proto.UpdateCustomerReq
+	24, // 61: proto.Dvdstore.DeleteCustomer:input_type -> proto.DeleteCustomerReq
+	26, // 62: proto.Dvdstore.GetProducts:input_type -> proto.GetProductsReq
+	28, // 63: proto.Dvdstore.SearchProducts:input_type -> proto.SearchProductsReq
+	30, // 64: proto.Dvdstore.GetProduct:input_type -> proto.GetProductReq
+	32, // 65: proto.Dvdstore.AddProduct:input_type -> proto.AddProductReq
+	34, // 66: proto.Dvdstore.UpdateProduct:input_type -> proto.UpdateProductReq
+	36, // 67: proto.Dvdstore.AdjustInventory:input_type -> proto.AdjustInventoryReq
+	38, // 68: proto.Dvdstore.DeleteProduct:input_type -> proto.DeleteProductReq
+	40, // 69: proto.Dvdstore.ListCategories:input_type -> proto.ListCategoriesReq
+	42, // 70: proto.Dvdstore.AddCategory:input_type -> proto.AddCategoryReq
+	44, // 71: proto.Dvdstore.GetOrder:input_type -> proto.GetOrderReq
+	46, // 72: proto.Dvdstore.GetCustomerOrders:input_type -> proto.GetCustomerOrdersReq
+	48, // 73: proto.Dvdstore.AddOrder:input_type -> proto.AddOrderReq
+	50, // 74: proto.Dvdstore.CancelOrder:input_type -> proto.CancelOrderReq
+	52, // 75: proto.Dvdstore.TransitionOrder:input_type -> proto.TransitionOrderReq
+	54, // 76: proto.Dvdstore.ReturnOrderLines:input_type -> proto.ReturnOrderLinesReq
+	56, // 77: proto.Dvdstore.DeleteOrder:input_type -> proto.DeleteOrderReq
+	58, // 78: proto.Dvdstore.AddPaymentMethod:input_type -> proto.AddPaymentMethodReq
+	60, // 79: proto.Dvdstore.GetPaymentMethods:input_type -> proto.GetPaymentMethodsReq
+	62, // 80: proto.Dvdstore.DeletePaymentMethod:input_type -> proto.DeletePaymentMethodReq
+	64, // 81: proto.Dvdstore.GetCart:input_type -> proto.GetCartReq
+	66, // 82: proto.Dvdstore.AddToCart:input_type -> proto.AddToCartReq
+	68, // 83: proto.Dvdstore.RemoveFromCart:input_type -> proto.RemoveFromCartReq
+	70, // 84: proto.Dvdstore.Checkout:input_type -> proto.CheckoutReq
+	15, // 85: proto.Dvdstore.GetCustomers:output_type -> proto.GetCustomersRes
+	17, // 86: proto.Dvdstore.GetCustomer:output_type -> proto.GetCustomerRes
+	19, // 87: proto.Dvdstore.AddCustomer:output_type -> proto.AddCustomerRes
+	21, // 88: proto.Dvdstore.Login:output_type -> proto.LoginRes
+	23, // 89: proto.Dvdstore.UpdateCustomer:output_type -> proto.UpdateCustomerRes
+	25, // 90: proto.Dvdstore.DeleteCustomer:output_type -> proto.DeleteCustomerRes
+	27, // 91: proto.Dvdstore.GetProducts:output_type -> proto.GetProductsRes
+	29, // 92: proto.Dvdstore.SearchProducts:output_type -> proto.SearchProductsRes
+	31, // 93: proto.Dvdstore.GetProduct:output_type -> proto.GetProductRes
+	33, // 94: proto.Dvdstore.AddProduct:output_type -> proto.AddProductRes
+	35, // 95: proto.Dvdstore.UpdateProduct:output_type -> proto.UpdateProductRes
+	37, // 96: proto.Dvdstore.AdjustInventory:output_type -> proto.AdjustInventoryRes
+	39, // 97: proto.Dvdstore.DeleteProduct:output_type -> proto.DeleteProductRes
+	41, // 98: proto.Dvdstore.ListCategories:output_type -> proto.ListCategoriesRes
+	43, // 99: proto.Dvdstore.AddCategory:output_type -> proto.AddCategoryRes
+	45, // 100: proto.Dvdstore.GetOrder:output_type -> proto.GetOrderRes
+	47, // 101: proto.Dvdstore.GetCustomerOrders:output_type -> proto.GetCustomerOrdersRes
+	49, // 102: proto.Dvdstore.AddOrder:output_type -> proto.AddOrderRes
+	51, // 103: proto.Dvdstore.CancelOrder:output_type -> proto.CancelOrderRes
+	53, // 104: proto.Dvdstore.TransitionOrder:output_type -> proto.TransitionOrderRes
+	55, // 105: proto.Dvdstore.ReturnOrderLines:output_type -> proto.ReturnOrderLinesRes
+	57, // 106: proto.Dvdstore.DeleteOrder:output_type -> proto.DeleteOrderRes
+	59, // 107: proto.Dvdstore.AddPaymentMethod:output_type -> proto.AddPaymentMethodRes
+	61, // 108: proto.Dvdstore.GetPaymentMethods:output_type -> proto.GetPaymentMethodsRes
+	63, // 109: proto.Dvdstore.DeletePaymentMethod:output_type -> proto.DeletePaymentMethodRes
+	65, // 110: proto.Dvdstore.GetCart:output_type -> proto.GetCartRes
+	67, // 111: proto.Dvdstore.AddToCart:output_type -> proto.AddToCartRes
+	69, // 112: proto.Dvdstore.RemoveFromCart:output_type -> proto.RemoveFromCartRes
+	71, // 113: proto.Dvdstore.Checkout:output_type -> proto.CheckoutRes
+	85, // [85:114] is the sub-list for method output_type
+	56, // [56:85] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
+}
+
+func init() { file_proto_dvdstore_proto_init() }
+func file_proto_dvdstore_proto_init() {
+	if File_proto_dvdstore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_dvdstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Customer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Category); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderReturn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomerRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCustomerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCustomerRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCustomerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCustomerRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCustomerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCustomerRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1652,8 +5273,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product); i {
+		file_proto_dvdstore_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,8 +5285,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_proto_dvdstore_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1676,8 +5297,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCustomersReq); i {
+		file_proto_dvdstore_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchProductsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1688,8 +5309,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCustomersRes); i {
+		file_proto_dvdstore_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchProductsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1700,8 +5321,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCustomerReq); i {
+		file_proto_dvdstore_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1712,8 +5333,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCustomerRes); i {
+		file_proto_dvdstore_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1724,8 +5345,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCustomerReq); i {
+		file_proto_dvdstore_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProductReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,8 +5357,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCustomerRes); i {
+		file_proto_dvdstore_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProductRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1748,8 +5369,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCustomerReq); i {
+		file_proto_dvdstore_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1760,8 +5381,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCustomerRes); i {
+		file_proto_dvdstore_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProductRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1772,8 +5393,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProductsReq); i {
+		file_proto_dvdstore_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustInventoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1784,8 +5405,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProductsRes); i {
+		file_proto_dvdstore_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustInventoryRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1796,8 +5417,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProductReq); i {
+		file_proto_dvdstore_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,8 +5429,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProductRes); i {
+		file_proto_dvdstore_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,8 +5441,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProductReq); i {
+		file_proto_dvdstore_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCategoriesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,8 +5453,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProductRes); i {
+		file_proto_dvdstore_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCategoriesRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,8 +5465,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProductReq); i {
+		file_proto_dvdstore_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCategoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1856,8 +5477,8 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProductRes); i {
+		file_proto_dvdstore_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCategoryRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1868,7 +5489,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrderReq); i {
 			case 0:
 				return &v.state
@@ -1880,7 +5501,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrderRes); i {
 			case 0:
 				return &v.state
@@ -1892,7 +5513,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCustomerOrdersReq); i {
 			case 0:
 				return &v.state
@@ -1904,7 +5525,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCustomerOrdersRes); i {
 			case 0:
 				return &v.state
@@ -1916,7 +5537,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddOrderReq); i {
 			case 0:
 				return &v.state
@@ -1928,7 +5549,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddOrderRes); i {
 			case 0:
 				return &v.state
@@ -1940,7 +5561,79 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionOrderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnOrderLinesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnOrderLinesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteOrderReq); i {
 			case 0:
 				return &v.state
@@ -1952,7 +5645,7 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
-		file_proto_dvdstore_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_dvdstore_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteOrderRes); i {
 			case 0:
 				return &v.state
@@ -1964,19 +5657,188 @@ func file_proto_dvdstore_proto_init() {
 				return nil
 			}
 		}
+		file_proto_dvdstore_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPaymentMethodReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPaymentMethodRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentMethodsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPaymentMethodsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePaymentMethodReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePaymentMethodRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCartRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToCartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToCartRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromCartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromCartRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckoutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dvdstore_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckoutRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_dvdstore_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
+			NumEnums:      3,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_dvdstore_proto_goTypes,
 		DependencyIndexes: file_proto_dvdstore_proto_depIdxs,
+		EnumInfos:         file_proto_dvdstore_proto_enumTypes,
 		MessageInfos:      file_proto_dvdstore_proto_msgTypes,
 	}.Build()
 	File_proto_dvdstore_proto = out.File
@@ -22,37 +22,100 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DvdstoreClient interface {
-	// GetCustomers returns list of all Customers limited by provided limit
+	// GetCustomers returns page of Customers limited by provided limit
+	// and sorted by provided field
 	GetCustomers(ctx context.Context, in *GetCustomersReq, opts ...grpc.CallOption) (*GetCustomersRes, error)
 	// GetCustomer returns Customer by provided id
 	GetCustomer(ctx context.Context, in *GetCustomerReq, opts ...grpc.CallOption) (*GetCustomerRes, error)
-	// AddCustomer adds passed Customer and returns his id.
-	// Passed customer "Id" field is ignored
+	// AddCustomer registers passed Customer with username and password
+	// and returns his id. Passed customer "Id" field is ignored
 	AddCustomer(ctx context.Context, in *AddCustomerReq, opts ...grpc.CallOption) (*AddCustomerRes, error)
+	// Login returns token of the customer with passed credentials. Token is
+	// passed in "authorization" metadata as "Bearer <token>"
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error)
+	// UpdateCustomer updates fields of Customer listed in update mask
+	// and returns updated Customer
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerReq, opts ...grpc.CallOption) (*UpdateCustomerRes, error)
 	// DeleteCustomer deletes Customer by provided id.
 	// Returns empty response if no errors were met
 	DeleteCustomer(ctx context.Context, in *DeleteCustomerReq, opts ...grpc.CallOption) (*DeleteCustomerRes, error)
-	// GetProducts returns list of all Products limited by provided limit
+	// GetProducts returns page of Products limited by provided limit
+	// and sorted by provided field
 	GetProducts(ctx context.Context, in *GetProductsReq, opts ...grpc.CallOption) (*GetProductsRes, error)
+	// SearchProducts returns page of Products matching full-text query on
+	// title and actor, categories, price range and stock availability
+	SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error)
 	// GetProduct returns Product by provided id
 	GetProduct(ctx context.Context, in *GetProductReq, opts ...grpc.CallOption) (*GetProductRes, error)
 	// AddProduct adds passed Product and returns his id
 	// Passed product "Id" field is ignored
 	AddProduct(ctx context.Context, in *AddProductReq, opts ...grpc.CallOption) (*AddProductRes, error)
+	// UpdateProduct updates fields of Product listed in update mask
+	// and returns updated Product
+	UpdateProduct(ctx context.Context, in *UpdateProductReq, opts ...grpc.CallOption) (*UpdateProductRes, error)
+	// AdjustInventory changes Product quantity in stock by provided delta.
+	// Returns out of inventory error if quantity becomes negative
+	AdjustInventory(ctx context.Context, in *AdjustInventoryReq, opts ...grpc.CallOption) (*AdjustInventoryRes, error)
 	// DeleteProduct deletes Product by provided id.
 	// Returns empty response if no errors were met
 	DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error)
+	// ListCategories returns all product Categories
+	ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesRes, error)
+	// AddCategory adds passed Category and returns its id
+	// Passed category "Id" field is ignored
+	AddCategory(ctx context.Context, in *AddCategoryReq, opts ...grpc.CallOption) (*AddCategoryRes, error)
 	// GetOrder gets order by provided id
 	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderRes, error)
-	// GetCustomerOrders returns customer orders by provided customer id
+	// GetCustomerOrders returns page of customer orders by provided customer id
+	// optionally filtered by order statuses and sorted by provided field
 	GetCustomerOrders(ctx context.Context, in *GetCustomerOrdersReq, opts ...grpc.CallOption) (*GetCustomerOrdersRes, error)
 	// AddOrder adds order for passed customer id with provided products
-	// and returns created order id. "Title" and "Price" fields in passed
-	// ProductList are ignored
+	// paid with provided payment method of the customer and returns created
+	// order id. Payment method must not be expired, order total is authorized
+	// on it before the order is created. "Title" and "Price" fields
+	// in passed ProductList are ignored. Requests with the same IdempotencyKey create
+	// the order only once, reusing the key for another request is rejected
 	AddOrder(ctx context.Context, in *AddOrderReq, opts ...grpc.CallOption) (*AddOrderRes, error)
-	// DeleteOrder deletes order with provided order id.
+	// CancelOrder cancels order with provided order id and returns
+	// its products to inventory, authorized payment is voided. Order can be
+	// cancelled only once. Returns empty response if no errors were met
+	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderRes, error)
+	// TransitionOrder moves order with provided order id to provided status
+	// and returns the order. Allowed transitions are: pending -> paid,
+	// pending -> cancelled, paid -> shipped, paid -> cancelled,
+	// shipped -> delivered, delivered -> refunded. Moving to cancelled
+	// status is the same as CancelOrder call, moving to refunded status
+	// returns all not returned products as ReturnOrderLines. Payment is
+	// captured when order is shipped
+	TransitionOrder(ctx context.Context, in *TransitionOrderReq, opts ...grpc.CallOption) (*TransitionOrderRes, error)
+	// ReturnOrderLines records return of provided quantities of delivered order
+	// products, returns them to inventory and refunds their amounts at order prices
+	// and tax rate. Products are returned at most in ordered quantities. Returns
+	// recorded return, the order itself is not changed
+	ReturnOrderLines(ctx context.Context, in *ReturnOrderLinesReq, opts ...grpc.CallOption) (*ReturnOrderLinesRes, error)
+	// DeleteOrder hard-deletes order with provided order id without
+	// returning its products to inventory. Reserved for admins, use
+	// CancelOrder to cancel orders.
 	// Returns empty response if no errors were met
 	DeleteOrder(ctx context.Context, in *DeleteOrderReq, opts ...grpc.CallOption) (*DeleteOrderRes, error)
+	// AddPaymentMethod tokenizes passed card and registers it as a payment
+	// method of the customer. Expired cards are rejected
+	AddPaymentMethod(ctx context.Context, in *AddPaymentMethodReq, opts ...grpc.CallOption) (*AddPaymentMethodRes, error)
+	// GetPaymentMethods returns payment methods of the customer
+	GetPaymentMethods(ctx context.Context, in *GetPaymentMethodsReq, opts ...grpc.CallOption) (*GetPaymentMethodsRes, error)
+	// DeletePaymentMethod deletes payment method by provided id. Orders paid
+	// with it are kept. Returns empty response if no errors were met
+	DeletePaymentMethod(ctx context.Context, in *DeletePaymentMethodReq, opts ...grpc.CallOption) (*DeletePaymentMethodRes, error)
+	// GetCart returns customer cart with current prices and stock availability.
+	// Carts expire after a period without changes, expired cart is empty
+	GetCart(ctx context.Context, in *GetCartReq, opts ...grpc.CallOption) (*GetCartRes, error)
+	// AddToCart adds quantity of the product to customer cart and returns the cart
+	AddToCart(ctx context.Context, in *AddToCartReq, opts ...grpc.CallOption) (*AddToCartRes, error)
+	// RemoveFromCart removes the product from customer cart and returns the cart
+	RemoveFromCart(ctx context.Context, in *RemoveFromCartReq, opts ...grpc.CallOption) (*RemoveFromCartRes, error)
+	// Checkout places an order of the cart items the same way as AddOrder and
+	// empties the cart. Returns created order
+	Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*CheckoutRes, error)
 }
 
 type dvdstoreClient struct {
@@ -90,6 +153,24 @@ func (c *dvdstoreClient) AddCustomer(ctx context.Context, in *AddCustomerReq, op
 	return out, nil
 }
 
+func (c *dvdstoreClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error) {
+	out := new(LoginRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerReq, opts ...grpc.CallOption) (*UpdateCustomerRes, error) {
+	out := new(UpdateCustomerRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/UpdateCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dvdstoreClient) DeleteCustomer(ctx context.Context, in *DeleteCustomerReq, opts ...grpc.CallOption) (*DeleteCustomerRes, error) {
 	out := new(DeleteCustomerRes)
 	err := c.cc.Invoke(ctx, "/proto.Dvdstore/DeleteCustomer", in, out, opts...)
@@ -108,6 +189,15 @@ func (c *dvdstoreClient) GetProducts(ctx context.Context, in *GetProductsReq, op
 	return out, nil
 }
 
+func (c *dvdstoreClient) SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error) {
+	out := new(SearchProductsRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/SearchProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dvdstoreClient) GetProduct(ctx context.Context, in *GetProductReq, opts ...grpc.CallOption) (*GetProductRes, error) {
 	out := new(GetProductRes)
 	err := c.cc.Invoke(ctx, "/proto.Dvdstore/GetProduct", in, out, opts...)
@@ -126,6 +216,24 @@ func (c *dvdstoreClient) AddProduct(ctx context.Context, in *AddProductReq, opts
 	return out, nil
 }
 
+func (c *dvdstoreClient) UpdateProduct(ctx context.Context, in *UpdateProductReq, opts ...grpc.CallOption) (*UpdateProductRes, error) {
+	out := new(UpdateProductRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/UpdateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) AdjustInventory(ctx context.Context, in *AdjustInventoryReq, opts ...grpc.CallOption) (*AdjustInventoryRes, error) {
+	out := new(AdjustInventoryRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/AdjustInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dvdstoreClient) DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error) {
 	out := new(DeleteProductRes)
 	err := c.cc.Invoke(ctx, "/proto.Dvdstore/DeleteProduct", in, out, opts...)
@@ -135,6 +243,24 @@ func (c *dvdstoreClient) DeleteProduct(ctx context.Context, in *DeleteProductReq
 	return out, nil
 }
 
+func (c *dvdstoreClient) ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesRes, error) {
+	out := new(ListCategoriesRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) AddCategory(ctx context.Context, in *AddCategoryReq, opts ...grpc.CallOption) (*AddCategoryRes, error) {
+	out := new(AddCategoryRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/AddCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dvdstoreClient) GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderRes, error) {
 	out := new(GetOrderRes)
 	err := c.cc.Invoke(ctx, "/proto.Dvdstore/GetOrder", in, out, opts...)
@@ -162,6 +288,33 @@ func (c *dvdstoreClient) AddOrder(ctx context.Context, in *AddOrderReq, opts ...
 	return out, nil
 }
 
+func (c *dvdstoreClient) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderRes, error) {
+	out := new(CancelOrderRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) TransitionOrder(ctx context.Context, in *TransitionOrderReq, opts ...grpc.CallOption) (*TransitionOrderRes, error) {
+	out := new(TransitionOrderRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/TransitionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) ReturnOrderLines(ctx context.Context, in *ReturnOrderLinesReq, opts ...grpc.CallOption) (*ReturnOrderLinesRes, error) {
+	out := new(ReturnOrderLinesRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/ReturnOrderLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dvdstoreClient) DeleteOrder(ctx context.Context, in *DeleteOrderReq, opts ...grpc.CallOption) (*DeleteOrderRes, error) {
 	out := new(DeleteOrderRes)
 	err := c.cc.Invoke(ctx, "/proto.Dvdstore/DeleteOrder", in, out, opts...)
@@ -171,41 +324,167 @@ func (c *dvdstoreClient) DeleteOrder(ctx context.Context, in *DeleteOrderReq, op
 	return out, nil
 }
 
+func (c *dvdstoreClient) AddPaymentMethod(ctx context.Context, in *AddPaymentMethodReq, opts ...grpc.CallOption) (*AddPaymentMethodRes, error) {
+	out := new(AddPaymentMethodRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/AddPaymentMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) GetPaymentMethods(ctx context.Context, in *GetPaymentMethodsReq, opts ...grpc.CallOption) (*GetPaymentMethodsRes, error) {
+	out := new(GetPaymentMethodsRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/GetPaymentMethods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) DeletePaymentMethod(ctx context.Context, in *DeletePaymentMethodReq, opts ...grpc.CallOption) (*DeletePaymentMethodRes, error) {
+	out := new(DeletePaymentMethodRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/DeletePaymentMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) GetCart(ctx context.Context, in *GetCartReq, opts ...grpc.CallOption) (*GetCartRes, error) {
+	out := new(GetCartRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/GetCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) AddToCart(ctx context.Context, in *AddToCartReq, opts ...grpc.CallOption) (*AddToCartRes, error) {
+	out := new(AddToCartRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/AddToCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) RemoveFromCart(ctx context.Context, in *RemoveFromCartReq, opts ...grpc.CallOption) (*RemoveFromCartRes, error) {
+	out := new(RemoveFromCartRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/RemoveFromCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dvdstoreClient) Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*CheckoutRes, error) {
+	out := new(CheckoutRes)
+	err := c.cc.Invoke(ctx, "/proto.Dvdstore/Checkout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DvdstoreServer is the server API for Dvdstore service.
 // All implementations must embed UnimplementedDvdstoreServer
 // for forward compatibility
 type DvdstoreServer interface {
-	// GetCustomers returns list of all Customers limited by provided limit
+	// GetCustomers returns page of Customers limited by provided limit
+	// and sorted by provided field
 	GetCustomers(context.Context, *GetCustomersReq) (*GetCustomersRes, error)
 	// GetCustomer returns Customer by provided id
 	GetCustomer(context.Context, *GetCustomerReq) (*GetCustomerRes, error)
-	// AddCustomer adds passed Customer and returns his id.
-	// Passed customer "Id" field is ignored
+	// AddCustomer registers passed Customer with username and password
+	// and returns his id. Passed customer "Id" field is ignored
 	AddCustomer(context.Context, *AddCustomerReq) (*AddCustomerRes, error)
+	// Login returns token of the customer with passed credentials. Token is
+	// passed in "authorization" metadata as "Bearer <token>"
+	Login(context.Context, *LoginReq) (*LoginRes, error)
+	// UpdateCustomer updates fields of Customer listed in update mask
+	// and returns updated Customer
+	UpdateCustomer(context.Context, *UpdateCustomerReq) (*UpdateCustomerRes, error)
 	// DeleteCustomer deletes Customer by provided id.
 	// Returns empty response if no errors were met
 	DeleteCustomer(context.Context, *DeleteCustomerReq) (*DeleteCustomerRes, error)
-	// GetProducts returns list of all Products limited by provided limit
+	// GetProducts returns page of Products limited by provided limit
+	// and sorted by provided field
 	GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error)
+	// SearchProducts returns page of Products matching full-text query on
+	// title and actor, categories, price range and stock availability
+	SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error)
 	// GetProduct returns Product by provided id
 	GetProduct(context.Context, *GetProductReq) (*GetProductRes, error)
 	// AddProduct adds passed Product and returns his id
 	// Passed product "Id" field is ignored
 	AddProduct(context.Context, *AddProductReq) (*AddProductRes, error)
+	// UpdateProduct updates fields of Product listed in update mask
+	// and returns updated Product
+	UpdateProduct(context.Context, *UpdateProductReq) (*UpdateProductRes, error)
+	// AdjustInventory changes Product quantity in stock by provided delta.
+	// Returns out of inventory error if quantity becomes negative
+	AdjustInventory(context.Context, *AdjustInventoryReq) (*AdjustInventoryRes, error)
 	// DeleteProduct deletes Product by provided id.
 	// Returns empty response if no errors were met
 	DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error)
+	// ListCategories returns all product Categories
+	ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesRes, error)
+	// AddCategory adds passed Category and returns its id
+	// Passed category "Id" field is ignored
+	AddCategory(context.Context, *AddCategoryReq) (*AddCategoryRes, error)
 	// GetOrder gets order by provided id
 	GetOrder(context.Context, *GetOrderReq) (*GetOrderRes, error)
-	// GetCustomerOrders returns customer orders by provided customer id
+	// GetCustomerOrders returns page of customer orders by provided customer id
+	// optionally filtered by order statuses and sorted by provided field
 	GetCustomerOrders(context.Context, *GetCustomerOrdersReq) (*GetCustomerOrdersRes, error)
 	// AddOrder adds order for passed customer id with provided products
-	// and returns created order id. "Title" and "Price" fields in passed
-	// ProductList are ignored
+	// paid with provided payment method of the customer and returns created
+	// order id. Payment method must not be expired, order total is authorized
+	// on it before the order is created. "Title" and "Price" fields
+	// in passed ProductList are ignored. Requests with the same IdempotencyKey create
+	// the order only once, reusing the key for another request is rejected
 	AddOrder(context.Context, *AddOrderReq) (*AddOrderRes, error)
-	// DeleteOrder deletes order with provided order id.
+	// CancelOrder cancels order with provided order id and returns
+	// its products to inventory, authorized payment is voided. Order can be
+	// cancelled only once. Returns empty response if no errors were met
+	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderRes, error)
+	// TransitionOrder moves order with provided order id to provided status
+	// and returns the order. Allowed transitions are: pending -> paid,
+	// pending -> cancelled, paid -> shipped, paid -> cancelled,
+	// shipped -> delivered, delivered -> refunded. Moving to cancelled
+	// status is the same as CancelOrder call, moving to refunded status
+	// returns all not returned products as ReturnOrderLines. Payment is
+	// captured when order is shipped
+	TransitionOrder(context.Context, *TransitionOrderReq) (*TransitionOrderRes, error)
+	// ReturnOrderLines records return of provided quantities of delivered order
+	// products, returns them to inventory and refunds their amounts at order prices
+	// and tax rate. Products are returned at most in ordered quantities. Returns
+	// recorded return, the order itself is not changed
+	ReturnOrderLines(context.Context, *ReturnOrderLinesReq) (*ReturnOrderLinesRes, error)
+	// DeleteOrder hard-deletes order with provided order id without
+	// returning its products to inventory. Reserved for admins, use
+	// CancelOrder to cancel orders.
 	// Returns empty response if no errors were met
 	DeleteOrder(context.Context, *DeleteOrderReq) (*DeleteOrderRes, error)
+	// AddPaymentMethod tokenizes passed card and registers it as a payment
+	// method of the customer. Expired cards are rejected
+	AddPaymentMethod(context.Context, *AddPaymentMethodReq) (*AddPaymentMethodRes, error)
+	// GetPaymentMethods returns payment methods of the customer
+	GetPaymentMethods(context.Context, *GetPaymentMethodsReq) (*GetPaymentMethodsRes, error)
+	// DeletePaymentMethod deletes payment method by provided id. Orders paid
+	// with it are kept. Returns empty response if no errors were met
+	DeletePaymentMethod(context.Context, *DeletePaymentMethodReq) (*DeletePaymentMethodRes, error)
+	// GetCart returns customer cart with current prices and stock availability.
+	// Carts expire after a period without changes, expired cart is empty
+	GetCart(context.Context, *GetCartReq) (*GetCartRes, error)
+	// AddToCart adds quantity of the product to customer cart and returns the cart
+	AddToCart(context.Context, *AddToCartReq) (*AddToCartRes, error)
+	// RemoveFromCart removes the product from customer cart and returns the cart
+	RemoveFromCart(context.Context, *RemoveFromCartReq) (*RemoveFromCartRes, error)
+	// Checkout places an order of the cart items the same way as AddOrder and
+	// empties the cart. Returns created order
+	Checkout(context.Context, *CheckoutReq) (*CheckoutRes, error)
 	mustEmbedUnimplementedDvdstoreServer()
 }
 
@@ -222,21 +501,42 @@ func (UnimplementedDvdstoreServer) GetCustomer(context.Context, *GetCustomerReq)
 func (UnimplementedDvdstoreServer) AddCustomer(context.Context, *AddCustomerReq) (*AddCustomerRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCustomer not implemented")
 }
+func (UnimplementedDvdstoreServer) Login(context.Context, *LoginReq) (*LoginRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedDvdstoreServer) UpdateCustomer(context.Context, *UpdateCustomerReq) (*UpdateCustomerRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
 func (UnimplementedDvdstoreServer) DeleteCustomer(context.Context, *DeleteCustomerReq) (*DeleteCustomerRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
 }
 func (UnimplementedDvdstoreServer) GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
 }
+func (UnimplementedDvdstoreServer) SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
 func (UnimplementedDvdstoreServer) GetProduct(context.Context, *GetProductReq) (*GetProductRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
 func (UnimplementedDvdstoreServer) AddProduct(context.Context, *AddProductReq) (*AddProductRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
 }
+func (UnimplementedDvdstoreServer) UpdateProduct(context.Context, *UpdateProductReq) (*UpdateProductRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedDvdstoreServer) AdjustInventory(context.Context, *AdjustInventoryReq) (*AdjustInventoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustInventory not implemented")
+}
 func (UnimplementedDvdstoreServer) DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedDvdstoreServer) ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedDvdstoreServer) AddCategory(context.Context, *AddCategoryReq) (*AddCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
 func (UnimplementedDvdstoreServer) GetOrder(context.Context, *GetOrderReq) (*GetOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
@@ -246,9 +546,39 @@ func (UnimplementedDvdstoreServer) GetCustomerOrders(context.Context, *GetCustom
 func (UnimplementedDvdstoreServer) AddOrder(context.Context, *AddOrderReq) (*AddOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOrder not implemented")
 }
+func (UnimplementedDvdstoreServer) CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedDvdstoreServer) TransitionOrder(context.Context, *TransitionOrderReq) (*TransitionOrderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionOrder not implemented")
+}
+func (UnimplementedDvdstoreServer) ReturnOrderLines(context.Context, *ReturnOrderLinesReq) (*ReturnOrderLinesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrderLines not implemented")
+}
 func (UnimplementedDvdstoreServer) DeleteOrder(context.Context, *DeleteOrderReq) (*DeleteOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
 }
+func (UnimplementedDvdstoreServer) AddPaymentMethod(context.Context, *AddPaymentMethodReq) (*AddPaymentMethodRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPaymentMethod not implemented")
+}
+func (UnimplementedDvdstoreServer) GetPaymentMethods(context.Context, *GetPaymentMethodsReq) (*GetPaymentMethodsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentMethods not implemented")
+}
+func (UnimplementedDvdstoreServer) DeletePaymentMethod(context.Context, *DeletePaymentMethodReq) (*DeletePaymentMethodRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentMethod not implemented")
+}
+func (UnimplementedDvdstoreServer) GetCart(context.Context, *GetCartReq) (*GetCartRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedDvdstoreServer) AddToCart(context.Context, *AddToCartReq) (*AddToCartRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToCart not implemented")
+}
+func (UnimplementedDvdstoreServer) RemoveFromCart(context.Context, *RemoveFromCartReq) (*RemoveFromCartRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCart not implemented")
+}
+func (UnimplementedDvdstoreServer) Checkout(context.Context, *CheckoutReq) (*CheckoutRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
 func (UnimplementedDvdstoreServer) mustEmbedUnimplementedDvdstoreServer() {}
 
 // UnsafeDvdstoreServer may be embedded to opt out of forward compatibility for this service.
@@ -316,6 +646,42 @@ func _Dvdstore_AddCustomer_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/UpdateCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).UpdateCustomer(ctx, req.(*UpdateCustomerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dvdstore_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCustomerReq)
 	if err := dec(in); err != nil {
@@ -352,6 +718,24 @@ func _Dvdstore_GetProducts_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).SearchProducts(ctx, req.(*SearchProductsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dvdstore_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductReq)
 	if err := dec(in); err != nil {
@@ -388,6 +772,42 @@ func _Dvdstore_AddProduct_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).UpdateProduct(ctx, req.(*UpdateProductReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_AdjustInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustInventoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).AdjustInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/AdjustInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).AdjustInventory(ctx, req.(*AdjustInventoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dvdstore_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProductReq)
 	if err := dec(in); err != nil {
@@ -406,6 +826,42 @@ func _Dvdstore_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).ListCategories(ctx, req.(*ListCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/AddCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).AddCategory(ctx, req.(*AddCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dvdstore_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderReq)
 	if err := dec(in); err != nil {
@@ -460,6 +916,60 @@ func _Dvdstore_AddOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).CancelOrder(ctx, req.(*CancelOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_TransitionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).TransitionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/TransitionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).TransitionOrder(ctx, req.(*TransitionOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_ReturnOrderLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnOrderLinesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).ReturnOrderLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/ReturnOrderLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).ReturnOrderLines(ctx, req.(*ReturnOrderLinesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dvdstore_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteOrderReq)
 	if err := dec(in); err != nil {
@@ -478,6 +988,132 @@ func _Dvdstore_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dvdstore_AddPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPaymentMethodReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).AddPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/AddPaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).AddPaymentMethod(ctx, req.(*AddPaymentMethodReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentMethodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).GetPaymentMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/GetPaymentMethods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).GetPaymentMethods(ctx, req.(*GetPaymentMethodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_DeletePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentMethodReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).DeletePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/DeletePaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).DeletePaymentMethod(ctx, req.(*DeletePaymentMethodReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/GetCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).GetCart(ctx, req.(*GetCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_AddToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).AddToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/AddToCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).AddToCart(ctx, req.(*AddToCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_RemoveFromCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).RemoveFromCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/RemoveFromCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).RemoveFromCart(ctx, req.(*RemoveFromCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dvdstore_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DvdstoreServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dvdstore/Checkout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DvdstoreServer).Checkout(ctx, req.(*CheckoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dvdstore_ServiceDesc is the grpc.ServiceDesc for Dvdstore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +1133,14 @@ var Dvdstore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCustomer",
 			Handler:    _Dvdstore_AddCustomer_Handler,
 		},
+		{
+			MethodName: "Login",
+			Handler:    _Dvdstore_Login_Handler,
+		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _Dvdstore_UpdateCustomer_Handler,
+		},
 		{
 			MethodName: "DeleteCustomer",
 			Handler:    _Dvdstore_DeleteCustomer_Handler,
@@ -505,6 +1149,10 @@ var Dvdstore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProducts",
 			Handler:    _Dvdstore_GetProducts_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _Dvdstore_SearchProducts_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _Dvdstore_GetProduct_Handler,
@@ -513,10 +1161,26 @@ var Dvdstore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddProduct",
 			Handler:    _Dvdstore_AddProduct_Handler,
 		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _Dvdstore_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "AdjustInventory",
+			Handler:    _Dvdstore_AdjustInventory_Handler,
+		},
 		{
 			MethodName: "DeleteProduct",
 			Handler:    _Dvdstore_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _Dvdstore_ListCategories_Handler,
+		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _Dvdstore_AddCategory_Handler,
+		},
 		{
 			MethodName: "GetOrder",
 			Handler:    _Dvdstore_GetOrder_Handler,
@@ -529,10 +1193,50 @@ var Dvdstore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddOrder",
 			Handler:    _Dvdstore_AddOrder_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Dvdstore_CancelOrder_Handler,
+		},
+		{
+			MethodName: "TransitionOrder",
+			Handler:    _Dvdstore_TransitionOrder_Handler,
+		},
+		{
+			MethodName: "ReturnOrderLines",
+			Handler:    _Dvdstore_ReturnOrderLines_Handler,
+		},
 		{
 			MethodName: "DeleteOrder",
 			Handler:    _Dvdstore_DeleteOrder_Handler,
 		},
+		{
+			MethodName: "AddPaymentMethod",
+			Handler:    _Dvdstore_AddPaymentMethod_Handler,
+		},
+		{
+			MethodName: "GetPaymentMethods",
+			Handler:    _Dvdstore_GetPaymentMethods_Handler,
+		},
+		{
+			MethodName: "DeletePaymentMethod",
+			Handler:    _Dvdstore_DeletePaymentMethod_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _Dvdstore_GetCart_Handler,
+		},
+		{
+			MethodName: "AddToCart",
+			Handler:    _Dvdstore_AddToCart_Handler,
+		},
+		{
+			MethodName: "RemoveFromCart",
+			Handler:    _Dvdstore_RemoveFromCart_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _Dvdstore_Checkout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/dvdstore.proto",
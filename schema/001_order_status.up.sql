@@ -0,0 +1,2 @@
+-- Order status is used to cancel orders without deleting them
+ALTER TABLE orders ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'pending';
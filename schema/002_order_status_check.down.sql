@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS ix_order_custid_status;
+ALTER TABLE orders DROP CONSTRAINT orders_status_check;
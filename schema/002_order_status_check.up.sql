@@ -0,0 +1,6 @@
+-- Order statuses lifecycle: pending, paid, shipped, delivered, cancelled, refunded
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('pending', 'paid', 'shipped', 'delivered', 'cancelled', 'refunded'));
+
+-- Customer orders are filtered by status
+CREATE INDEX ix_order_custid_status ON orders (customerid, status);
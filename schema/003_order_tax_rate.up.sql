@@ -0,0 +1,5 @@
+-- Tax rate applied to the order
+ALTER TABLE orders ADD COLUMN taxrate NUMERIC(6,5) NOT NULL DEFAULT 0;
+
+-- Restore rates of existing orders from their amounts
+UPDATE orders SET taxrate = ROUND(tax / netamount, 5) WHERE netamount > 0;
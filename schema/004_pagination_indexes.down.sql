@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_order_custid_date_id;
+DROP INDEX IF EXISTS ix_prod_price_id;
+DROP INDEX IF EXISTS ix_prod_title_id;
+DROP INDEX IF EXISTS ix_cust_lastname_id;
@@ -0,0 +1,5 @@
+-- Indexes backing keyset pagination of the list RPCs
+CREATE INDEX IF NOT EXISTS ix_cust_lastname_id ON customers (lastname, customerid);
+CREATE INDEX IF NOT EXISTS ix_prod_title_id ON products (title, prod_id);
+CREATE INDEX IF NOT EXISTS ix_prod_price_id ON products (price, prod_id);
+CREATE INDEX IF NOT EXISTS ix_order_custid_date_id ON orders (customerid, orderdate, orderid);
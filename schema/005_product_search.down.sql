@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_inv_in_stock;
+DROP INDEX IF EXISTS ix_prod_category;
+DROP INDEX IF EXISTS ix_prod_search;
+ALTER TABLE products DROP COLUMN search;
@@ -0,0 +1,7 @@
+-- Full-text search document of product title and actor
+ALTER TABLE products ADD COLUMN search tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(actor, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS ix_prod_search ON products USING GIN (search);
+CREATE INDEX IF NOT EXISTS ix_prod_category ON products (category);
+CREATE INDEX IF NOT EXISTS ix_inv_in_stock ON inventory (prod_id) WHERE quan_in_stock > 0;
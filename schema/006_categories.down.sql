@@ -0,0 +1,2 @@
+-- Sequence stays past the sample data ids
+DROP INDEX IF EXISTS ix_categories_name;
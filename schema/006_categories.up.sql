@@ -0,0 +1,7 @@
+-- Category names are unique regardless of case
+CREATE UNIQUE INDEX IF NOT EXISTS ix_categories_name ON categories (lower(categoryname));
+
+-- Sample data is loaded with explicit ids, move the sequence past them
+SELECT setval(pg_get_serial_sequence('categories', 'category'),
+    (SELECT COALESCE(max(category), 0) + 1 FROM categories), false);
+
@@ -0,0 +1,9 @@
+-- Idempotency keys of AddOrder requests. Expired keys are reused by new requests
+CREATE TABLE IF NOT EXISTS order_idempotency (
+    idempotency_key VARCHAR(100) PRIMARY KEY,
+    request_hash CHAR(64) NOT NULL,
+    orderid INTEGER NOT NULL REFERENCES orders (orderid) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ix_order_idempotency_orderid ON order_idempotency (orderid);
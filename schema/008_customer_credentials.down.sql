@@ -0,0 +1,2 @@
+-- Password column keeps its width, stored bcrypt hashes don't fit the original one
+DROP INDEX IF EXISTS customers_lower_username_key;
@@ -0,0 +1,6 @@
+-- Password column fits bcrypt hashes
+ALTER TABLE customers ALTER COLUMN password TYPE VARCHAR(100);
+
+-- Usernames are unique case-insensitively. Customers added before registration
+-- have empty usernames and are skipped
+CREATE UNIQUE INDEX customers_lower_username_key ON customers (lower(username)) WHERE username <> '';
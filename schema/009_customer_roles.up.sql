@@ -0,0 +1,4 @@
+-- Roles of customer accounts. Staff and admins are promoted manually:
+-- UPDATE customers SET role = 'admin' WHERE lower(username) = lower('...');
+ALTER TABLE customers ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'customer'
+    CONSTRAINT customers_role_check CHECK (role IN ('admin', 'staff', 'customer'));
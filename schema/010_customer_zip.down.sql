@@ -0,0 +1,2 @@
+ALTER TABLE customers ALTER COLUMN zip TYPE INTEGER
+    USING CASE WHEN zip ~ '^[0-9]{5}' THEN substr(zip, 1, 5)::integer ELSE 0 END;
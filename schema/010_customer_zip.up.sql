@@ -0,0 +1,4 @@
+-- Zip is a string to keep leading zeros of US ZIP codes, ZIP+4 and postal codes of other
+-- countries. Sample customers outside US have zip 0 that becomes empty
+ALTER TABLE customers ALTER COLUMN zip TYPE VARCHAR(10)
+    USING CASE WHEN zip > 0 THEN lpad(zip::text, 5, '0') ELSE '' END;
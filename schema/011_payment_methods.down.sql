@@ -0,0 +1,8 @@
+-- Dropped credit card data can't be restored, columns are added back blank
+ALTER TABLE customers ADD COLUMN creditcardtype INTEGER NOT NULL DEFAULT -1,
+    ADD COLUMN creditcard VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN creditcardexpiration VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE orders DROP COLUMN payment_method_id;
+
+DROP TABLE IF EXISTS payment_methods;
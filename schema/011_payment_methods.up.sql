@@ -0,0 +1,22 @@
+-- Tokenized payment cards of customers. Card numbers are kept only by the tokenizer
+CREATE TABLE IF NOT EXISTS payment_methods (
+    id SERIAL PRIMARY KEY,
+    customerid INTEGER NOT NULL REFERENCES customers (customerid) ON DELETE CASCADE,
+    token VARCHAR(100) NOT NULL,
+    brand VARCHAR(20) NOT NULL,
+    last_four CHAR(4) NOT NULL,
+    exp_month SMALLINT NOT NULL CONSTRAINT payment_methods_exp_month_check CHECK (exp_month BETWEEN 1 AND 12),
+    exp_year SMALLINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS ix_payment_methods_customerid ON payment_methods (customerid);
+
+-- Orders placed before payment methods have none
+ALTER TABLE orders ADD COLUMN payment_method_id INTEGER
+    REFERENCES payment_methods (id) ON DELETE SET NULL;
+
+-- Raw credit card data of sample customers is replaced by payment methods
+ALTER TABLE customers DROP COLUMN creditcardtype,
+    DROP COLUMN creditcard,
+    DROP COLUMN creditcardexpiration;
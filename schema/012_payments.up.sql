@@ -0,0 +1,12 @@
+-- Payments of orders. Order total is authorized when the order is placed, captured
+-- when it's shipped and voided when it's cancelled. Orders placed before have none
+CREATE TABLE IF NOT EXISTS payments (
+    id SERIAL PRIMARY KEY,
+    orderid INTEGER NOT NULL UNIQUE REFERENCES orders (orderid) ON DELETE CASCADE,
+    authorization_id VARCHAR(100) NOT NULL,
+    amount NUMERIC(12,2) NOT NULL,
+    status VARCHAR(16) NOT NULL
+        CONSTRAINT payments_status_check CHECK (status IN ('authorized', 'captured', 'voided')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
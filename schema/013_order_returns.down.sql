@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS order_return_lines;
+DROP TABLE IF EXISTS order_returns;
+
+ALTER TABLE orderlines DROP COLUMN price;
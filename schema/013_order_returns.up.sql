@@ -0,0 +1,26 @@
+-- Price of the product at the time of the order, so orders and returns keep their prices.
+-- Lines placed before are priced by the current product prices
+ALTER TABLE orderlines ADD COLUMN price NUMERIC(12,2) NOT NULL DEFAULT 0;
+
+UPDATE orderlines ol SET price = p.price FROM products p WHERE p.prod_id = ol.prod_id;
+
+-- Returns of ordered products with refunded amounts
+CREATE TABLE IF NOT EXISTS order_returns (
+    id SERIAL PRIMARY KEY,
+    orderid INTEGER NOT NULL REFERENCES orders (orderid) ON DELETE CASCADE,
+    returndate TIMESTAMPTZ NOT NULL DEFAULT now(),
+    netamount NUMERIC(12,2) NOT NULL,
+    tax NUMERIC(12,2) NOT NULL,
+    totalamount NUMERIC(12,2) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ix_order_returns_orderid ON order_returns (orderid);
+
+-- Returned quantities of products at order prices
+CREATE TABLE IF NOT EXISTS order_return_lines (
+    return_id INTEGER NOT NULL REFERENCES order_returns (id) ON DELETE CASCADE,
+    prod_id INTEGER NOT NULL,
+    quantity INTEGER NOT NULL CONSTRAINT order_return_lines_quantity_check CHECK (quantity > 0),
+    price NUMERIC(12,2) NOT NULL,
+    PRIMARY KEY (return_id, prod_id)
+);
@@ -0,0 +1,15 @@
+-- Carts of customers, a cart expires after a period without changes since updated_at
+CREATE TABLE IF NOT EXISTS carts (
+    customerid INTEGER PRIMARY KEY
+        CONSTRAINT carts_customerid_fkey REFERENCES customers (customerid) ON DELETE CASCADE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Products in carts with quantities, deleted products are removed from carts
+CREATE TABLE IF NOT EXISTS cart_items (
+    customerid INTEGER NOT NULL REFERENCES carts (customerid) ON DELETE CASCADE,
+    prod_id INTEGER NOT NULL
+        CONSTRAINT cart_items_prod_id_fkey REFERENCES products (prod_id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CONSTRAINT cart_items_quantity_check CHECK (quantity > 0),
+    PRIMARY KEY (customerid, prod_id)
+);
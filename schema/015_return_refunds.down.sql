@@ -0,0 +1 @@
+ALTER TABLE order_returns DROP COLUMN refund_status;
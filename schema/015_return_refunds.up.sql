@@ -0,0 +1,5 @@
+-- Refund status of returns. Return is recorded as pending and marked refunded once the
+-- payment gateway refunded its total, pending returns are reconciled with the gateway.
+-- Returns recorded before were never refunded through the gateway, so they are pending
+ALTER TABLE order_returns ADD COLUMN refund_status VARCHAR(16) NOT NULL DEFAULT 'pending'
+    CONSTRAINT order_returns_refund_status_check CHECK (refund_status IN ('pending', 'refunded'));
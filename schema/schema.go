@@ -0,0 +1,10 @@
+// Package schema embeds SQL migrations applied on top of Dell DVD store database.
+// Migration NNN_name is a pair of NNN_name.up.sql and NNN_name.down.sql files
+package schema
+
+import "embed"
+
+// Migrations are SQL files of schema migrations
+//
+//go:embed *.sql
+var Migrations embed.FS